@@ -0,0 +1,270 @@
+package chainhooks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"strings"
+)
+
+// ============================================================================
+// Amount
+// ============================================================================
+
+// STX unit constants.
+const (
+	// STXDecimals is the number of decimal places of STX.
+	STXDecimals = 6
+	// MicroSTXPerSTX is the number of micro-STX in one STX.
+	MicroSTXPerSTX = 1_000_000
+)
+
+// MaxTokenDecimals is the largest number of decimals accepted by
+// ParseUnits and FormatUnits. 10^38 is the largest power of ten that fits in
+// a uint128.
+const MaxTokenDecimals = 38
+
+var (
+	// ErrAmountOverflow is returned when an arithmetic result exceeds the
+	// range of a uint128.
+	ErrAmountOverflow = errors.New("amount overflows uint128")
+	// ErrAmountUnderflow is returned when a subtraction result is negative.
+	ErrAmountUnderflow = errors.New("amount underflows zero")
+	// ErrAmountDivisionByZero is returned when dividing by a zero amount.
+	ErrAmountDivisionByZero = errors.New("amount division by zero")
+)
+
+// maxUint128 is the largest value of a Clarity uint.
+var maxUint128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+// Amount is an unsigned 128-bit integer, the range of a Clarity uint, used for
+// token amounts in base units (micro-STX for STX).
+//
+// The zero value is zero. Amounts are comparable with == and marshal to JSON
+// as decimal strings, the format used by filters and webhook payloads; JSON
+// numbers are also accepted when decoding.
+type Amount struct {
+	hi, lo uint64
+}
+
+// NewAmount creates an amount from a uint64.
+func NewAmount(v uint64) Amount {
+	return Amount{lo: v}
+}
+
+// MicroSTX creates an amount of micro-STX.
+func MicroSTX(v uint64) Amount {
+	return NewAmount(v)
+}
+
+// AmountPtr returns a pointer to the given amount.
+func AmountPtr(a Amount) *Amount {
+	return &a
+}
+
+// ParseAmount parses a base-10 unsigned integer in the uint128 range.
+func ParseAmount(s string) (Amount, error) {
+	if s == "" || strings.TrimLeft(s, "0123456789") != "" {
+		return Amount{}, &ValidationError{
+			Field:  "amount",
+			Reason: fmt.Sprintf("amount %q must be an unsigned base-10 integer", s),
+		}
+	}
+	n, _ := new(big.Int).SetString(s, 10)
+	return AmountFromBig(n)
+}
+
+// MustParseAmount is like ParseAmount but panics if s is invalid.
+func MustParseAmount(s string) Amount {
+	a, err := ParseAmount(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// AmountFromBig converts a big.Int in the uint128 range to an Amount.
+func AmountFromBig(n *big.Int) (Amount, error) {
+	if n.Sign() < 0 {
+		return Amount{}, ErrAmountUnderflow
+	}
+	if n.Cmp(maxUint128) > 0 {
+		return Amount{}, ErrAmountOverflow
+	}
+	lo := new(big.Int).And(n, new(big.Int).SetUint64(^uint64(0)))
+	hi := new(big.Int).Rsh(n, 64)
+	return Amount{hi: hi.Uint64(), lo: lo.Uint64()}, nil
+}
+
+// Big returns the amount as a new big.Int.
+func (a Amount) Big() *big.Int {
+	n := new(big.Int).SetUint64(a.hi)
+	n.Lsh(n, 64)
+	return n.Or(n, new(big.Int).SetUint64(a.lo))
+}
+
+// Uint64 returns the amount as a uint64 and whether it fits.
+func (a Amount) Uint64() (uint64, bool) {
+	return a.lo, a.hi == 0
+}
+
+// IsZero reports whether the amount is zero.
+func (a Amount) IsZero() bool {
+	return a.hi == 0 && a.lo == 0
+}
+
+// String returns the amount as a base-10 integer.
+func (a Amount) String() string {
+	if a.hi == 0 {
+		return fmt.Sprintf("%d", a.lo)
+	}
+	return a.Big().String()
+}
+
+// Cmp compares a and b and returns -1, 0 or +1.
+func (a Amount) Cmp(b Amount) int {
+	switch {
+	case a.hi < b.hi || (a.hi == b.hi && a.lo < b.lo):
+		return -1
+	case a == b:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Add returns a+b, or ErrAmountOverflow.
+func (a Amount) Add(b Amount) (Amount, error) {
+	lo, carry := bits.Add64(a.lo, b.lo, 0)
+	hi, carry := bits.Add64(a.hi, b.hi, carry)
+	if carry != 0 {
+		return Amount{}, ErrAmountOverflow
+	}
+	return Amount{hi: hi, lo: lo}, nil
+}
+
+// Sub returns a-b, or ErrAmountUnderflow if b is larger than a.
+func (a Amount) Sub(b Amount) (Amount, error) {
+	lo, borrow := bits.Sub64(a.lo, b.lo, 0)
+	hi, borrow := bits.Sub64(a.hi, b.hi, borrow)
+	if borrow != 0 {
+		return Amount{}, ErrAmountUnderflow
+	}
+	return Amount{hi: hi, lo: lo}, nil
+}
+
+// Mul returns a*b, or ErrAmountOverflow.
+func (a Amount) Mul(b Amount) (Amount, error) {
+	return AmountFromBig(new(big.Int).Mul(a.Big(), b.Big()))
+}
+
+// Div returns a/b rounded toward zero, or ErrAmountDivisionByZero.
+func (a Amount) Div(b Amount) (Amount, error) {
+	if b.IsZero() {
+		return Amount{}, ErrAmountDivisionByZero
+	}
+	return AmountFromBig(new(big.Int).Quo(a.Big(), b.Big()))
+}
+
+// Mod returns a modulo b, or ErrAmountDivisionByZero.
+func (a Amount) Mod(b Amount) (Amount, error) {
+	if b.IsZero() {
+		return Amount{}, ErrAmountDivisionByZero
+	}
+	return AmountFromBig(new(big.Int).Rem(a.Big(), b.Big()))
+}
+
+// ============================================================================
+// Unit Conversion
+// ============================================================================
+
+// ParseUnits parses a decimal token quantity such as "1.5" into base units of
+// a token with the given number of decimals. Quantities with more fractional
+// digits than decimals are rejected rather than rounded.
+func ParseUnits(s string, decimals uint8) (Amount, error) {
+	if decimals > MaxTokenDecimals {
+		return Amount{}, fmt.Errorf("decimals %d exceeds the maximum of %d", decimals, MaxTokenDecimals)
+	}
+
+	whole, frac, hasFrac := strings.Cut(s, ".")
+	if whole == "" || (hasFrac && frac == "") {
+		return Amount{}, &ValidationError{
+			Field:  "amount",
+			Reason: fmt.Sprintf("invalid decimal quantity %q", s),
+		}
+	}
+	if len(frac) > int(decimals) {
+		return Amount{}, &ValidationError{
+			Field:  "amount",
+			Reason: fmt.Sprintf("quantity %q has more than %d decimal places", s, decimals),
+		}
+	}
+
+	return ParseAmount(whole + frac + strings.Repeat("0", int(decimals)-len(frac)))
+}
+
+// FormatUnits formats the amount as a decimal quantity of a token with the
+// given number of decimals, without trailing zeros, e.g. "1.5".
+func (a Amount) FormatUnits(decimals uint8) string {
+	digits := a.String()
+	if decimals == 0 {
+		return digits
+	}
+	if len(digits) <= int(decimals) {
+		digits = strings.Repeat("0", int(decimals)-len(digits)+1) + digits
+	}
+
+	whole := digits[:len(digits)-int(decimals)]
+	frac := strings.TrimRight(digits[len(digits)-int(decimals):], "0")
+	if frac == "" {
+		return whole
+	}
+	return whole + "." + frac
+}
+
+// ParseSTX parses a quantity of STX such as "1.5" into micro-STX.
+func ParseSTX(s string) (Amount, error) {
+	return ParseUnits(s, STXDecimals)
+}
+
+// STX formats an amount of micro-STX as a quantity of STX, e.g. "1.5".
+func (a Amount) STX() string {
+	return a.FormatUnits(STXDecimals)
+}
+
+// ============================================================================
+// Encoding
+// ============================================================================
+
+// MarshalText implements encoding.TextMarshaler.
+func (a Amount) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (a *Amount) UnmarshalText(text []byte) error {
+	parsed, err := ParseAmount(string(text))
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// UnmarshalJSON accepts the amount as a JSON string or a JSON integer. Like
+// the standard library decoders, it leaves the amount unchanged for null.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return a.UnmarshalText([]byte(s))
+	}
+	return a.UnmarshalText(data)
+}
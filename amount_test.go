@@ -0,0 +1,106 @@
+package chainhooks
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestAmountArithmetic(t *testing.T) {
+	max := MustParseAmount("340282366920938463463374607431768211455")
+	one := NewAmount(1)
+
+	if _, err := max.Add(one); !errors.Is(err, ErrAmountOverflow) {
+		t.Errorf("expected overflow, got %v", err)
+	}
+	if _, err := NewAmount(0).Sub(one); !errors.Is(err, ErrAmountUnderflow) {
+		t.Errorf("expected underflow, got %v", err)
+	}
+	if _, err := max.Div(Amount{}); !errors.Is(err, ErrAmountDivisionByZero) {
+		t.Errorf("expected division by zero, got %v", err)
+	}
+	if _, err := ParseAmount("340282366920938463463374607431768211456"); !errors.Is(err, ErrAmountOverflow) {
+		t.Errorf("expected overflow when parsing 2^128, got %v", err)
+	}
+
+	// Carry from the low into the high word
+	sum, err := NewAmount(^uint64(0)).Add(one)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum.String() != "18446744073709551616" {
+		t.Errorf("got %s", sum)
+	}
+	if sum.Cmp(NewAmount(^uint64(0))) != 1 || one.Cmp(sum) != -1 || sum.Cmp(sum) != 0 {
+		t.Error("unexpected comparison result")
+	}
+
+	back, err := sum.Sub(one)
+	if err != nil || back != NewAmount(^uint64(0)) {
+		t.Errorf("got %s, %v", back, err)
+	}
+}
+
+func TestAmountUnits(t *testing.T) {
+	tests := []struct {
+		quantity string
+		decimals uint8
+		base     string
+		format   string
+	}{
+		{"1.5", STXDecimals, "1500000", "1.5"},
+		{"0.000001", STXDecimals, "1", "0.000001"},
+		{"42", STXDecimals, "42000000", "42"},
+		{"1.000", 8, "100000000", "1"},
+		{"7", 0, "7", "7"},
+	}
+
+	for _, tt := range tests {
+		a, err := ParseUnits(tt.quantity, tt.decimals)
+		if err != nil {
+			t.Errorf("%s: %v", tt.quantity, err)
+			continue
+		}
+		if a.String() != tt.base {
+			t.Errorf("%s: got %s base units, want %s", tt.quantity, a, tt.base)
+		}
+		if got := a.FormatUnits(tt.decimals); got != tt.format {
+			t.Errorf("%s: formatted as %s, want %s", tt.quantity, got, tt.format)
+		}
+	}
+
+	for _, bad := range []string{"1.0000001", "-1", "1.", ".5", "1e6", ""} {
+		if _, err := ParseSTX(bad); err == nil {
+			t.Errorf("%q: expected an error", bad)
+		}
+	}
+}
+
+func TestAmountJSON(t *testing.T) {
+	var payload struct {
+		Amount Amount `json:"amount"`
+		Fee    Amount `json:"fee"`
+	}
+	if err := json.Unmarshal([]byte(`{"amount":"2500000","fee":180}`), &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Amount.STX() != "2.5" || payload.Fee != MicroSTX(180) {
+		t.Fatalf("unexpected payload %+v", payload)
+	}
+
+	out, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `{"amount":"2500000","fee":"180"}` {
+		t.Fatalf("got %s", out)
+	}
+
+	// null leaves the amount unchanged
+	if err := json.Unmarshal([]byte(`{"amount":null,"fee":null}`), &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Amount != MicroSTX(2500000) || payload.Fee != MicroSTX(180) {
+		t.Fatalf("null changed the payload to %+v", payload)
+	}
+}
@@ -0,0 +1,48 @@
+package chainhooks
+
+import (
+	"context"
+)
+
+// ChainhooksAPI is the set of Chainhooks API operations implemented by Client.
+//
+// Depend on this interface instead of *Client to substitute a fake in tests;
+// see the chainhooksfakes package for a configurable implementation.
+// AllChainhooksSeq is left out because it requires Go 1.23; it is a thin
+// wrapper over AllChainhooks.
+type ChainhooksAPI interface {
+	// Chainhook management
+	RegisterChainhook(ctx context.Context, definition *ChainhookDefinition) (*Chainhook, error)
+	UpdateChainhook(ctx context.Context, uuid UUID, definition *ChainhookDefinition) (*Chainhook, error)
+	PatchChainhook(ctx context.Context, uuid UUID, patch *ChainhookPatch) (*Chainhook, error)
+	GetChainhooks(ctx context.Context, opts *PaginationOptions) (*PaginatedChainhookResponse, error)
+	GetChainhook(ctx context.Context, uuid UUID) (*Chainhook, error)
+	EnableChainhook(ctx context.Context, uuid UUID, enabled bool) error
+	BulkEnableChainhooks(ctx context.Context, request *BulkEnableChainhooksRequest) (*BulkEnableChainhooksResponse, error)
+	DeleteChainhook(ctx context.Context, uuid UUID) error
+
+	// Listing and search
+	AllChainhooks(ctx context.Context, pageSize uint64) *ChainhookIterator
+	ListChainhooksParallel(ctx context.Context, pageSize uint64) ([]Chainhook, error)
+	GetChainhooksByUUID(ctx context.Context, uuids []UUID) ([]ChainhookResult, error)
+	FindChainhooks(ctx context.Context, query *ChainhookQuery) ([]Chainhook, error)
+	FindByName(ctx context.Context, name string) ([]Chainhook, error)
+	FindByWebhookURL(ctx context.Context, webhookURL string) ([]Chainhook, error)
+
+	// Bulk enable
+	PreviewBulkEnable(ctx context.Context, request *BulkEnableChainhooksRequest) (*BulkEnablePreview, error)
+
+	// Consumer secret
+	RotateConsumerSecret(ctx context.Context) (*ConsumerSecretResponse, error)
+	GetConsumerSecret(ctx context.Context) (*ConsumerSecretResponse, error)
+	DeleteConsumerSecret(ctx context.Context) error
+
+	// Evaluation
+	EvaluateChainhook(ctx context.Context, uuid UUID, blockHeight uint64) error
+
+	// Status
+	GetStatus(ctx context.Context) (*ApiStatusResponse, error)
+}
+
+// Ensure Client implements ChainhooksAPI.
+var _ ChainhooksAPI = (*Client)(nil)
@@ -0,0 +1,112 @@
+package chainhooks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tony1908/chainhooks-client-go/stacks"
+)
+
+// ============================================================================
+// Asset Identifier
+// ============================================================================
+
+// AssetIdentifier identifies a fungible or non-fungible token by the contract
+// that defines it and its asset name, in the canonical form
+// "<address>.<contract-name>::<asset-name>", for example
+// "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7.my-token::tkn".
+//
+// It marshals to JSON as that string. Use ParseAssetIdentifier or
+// NewAssetIdentifier to build a validated identifier.
+type AssetIdentifier string
+
+// assetSeparator separates the contract identifier from the asset name.
+const assetSeparator = "::"
+
+// maxClarityNameLength is the maximum length of a Clarity identifier.
+const maxClarityNameLength = 128
+
+// NewAssetIdentifier builds an asset identifier from a contract identifier
+// ("<address>.<contract-name>") and an asset name, validating both.
+func NewAssetIdentifier(contractID, assetName string) (AssetIdentifier, error) {
+	return ParseAssetIdentifier(contractID + assetSeparator + assetName)
+}
+
+// ParseAssetIdentifier parses and validates an asset identifier of the form
+// "<address>.<contract-name>::<asset-name>".
+func ParseAssetIdentifier(s string) (AssetIdentifier, error) {
+	id := AssetIdentifier(s)
+	if err := id.Validate(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// MustParseAssetIdentifier is like ParseAssetIdentifier but panics if the
+// identifier is invalid. It is intended for constants in tests and static
+// configuration.
+func MustParseAssetIdentifier(s string) AssetIdentifier {
+	id, err := ParseAssetIdentifier(s)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// String returns the identifier in its canonical form.
+func (a AssetIdentifier) String() string {
+	return string(a)
+}
+
+// Contract returns the contract identifier part, "<address>.<contract-name>".
+func (a AssetIdentifier) Contract() string {
+	contract, _, _ := strings.Cut(string(a), assetSeparator)
+	return contract
+}
+
+// Address returns the address of the contract that defines the asset.
+func (a AssetIdentifier) Address() string {
+	address, _, _ := strings.Cut(a.Contract(), ".")
+	return address
+}
+
+// ContractName returns the name of the contract that defines the asset.
+func (a AssetIdentifier) ContractName() string {
+	_, name, _ := strings.Cut(a.Contract(), ".")
+	return name
+}
+
+// AssetName returns the asset name part.
+func (a AssetIdentifier) AssetName() string {
+	_, name, _ := strings.Cut(string(a), assetSeparator)
+	return name
+}
+
+// Validate checks the syntax of every component of the identifier.
+func (a AssetIdentifier) Validate() error {
+	if a == "" {
+		return &ValidationError{
+			Field:  "asset",
+			Reason: "asset identifier is required",
+		}
+	}
+	if !strings.Contains(string(a), assetSeparator) {
+		return &ValidationError{
+			Field:  "asset",
+			Reason: fmt.Sprintf("asset identifier %q must have the form <address>.<contract-name>::<asset-name>", a),
+		}
+	}
+	if _, _, err := stacks.ParseContractIdentifier(a.Contract()); err != nil {
+		return &ValidationError{
+			Field:  "asset",
+			Reason: fmt.Sprintf("asset identifier %q: %v", a, err),
+		}
+	}
+	if name := a.AssetName(); len(name) > maxClarityNameLength || !clarityNamePattern.MatchString(name) {
+		return &ValidationError{
+			Field:  "asset",
+			Reason: fmt.Sprintf("asset identifier %q: invalid asset name %q", a, name),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package chainhooks
+
+import (
+	"context"
+)
+
+// ============================================================================
+// Bulk Enable Preview
+// ============================================================================
+
+// BulkEnablePreview lists the chainhooks a bulk enable request selects.
+type BulkEnablePreview struct {
+	// Enabled is the state the request sets.
+	Enabled bool
+	// WouldChange lists the selected chainhooks not yet in that state.
+	WouldChange []Chainhook
+	// Unchanged lists the selected chainhooks already in that state.
+	Unchanged []Chainhook
+}
+
+// Matched returns the number of chainhooks the request selects.
+func (p *BulkEnablePreview) Matched() int {
+	return len(p.WouldChange) + len(p.Unchanged)
+}
+
+// Query returns a ChainhookQuery selecting the same chainhooks as the
+// request. Every criterion given must hold, and a chainhook matches a list
+// of UUIDs or statuses if it matches any of them.
+func (r *BulkEnableChainhooksRequest) Query() *ChainhookQuery {
+	query := NewChainhookQuery()
+	if len(r.UUIDs) > 0 {
+		query.UUID(r.UUIDs...)
+	}
+	if r.WebhookURL != nil {
+		query.WebhookURL(*r.WebhookURL)
+	}
+	if len(r.Statuses) > 0 {
+		query.Status(r.Statuses...)
+	}
+	return query
+}
+
+// PreviewBulkEnable evaluates the selection of a bulk enable request
+// client-side against the full listing, without changing anything. The
+// listing is always fetched from the server; cached reads are neither used
+// nor evicted.
+//
+//	preview, err := client.PreviewBulkEnable(ctx, BulkEnableByStatus(false, ChainhookStatusStreaming))
+//	for _, hook := range preview.WouldChange {
+//		fmt.Println(hook.UUID, hook.Definition.Name)
+//	}
+func (c *Client) PreviewBulkEnable(ctx context.Context, request *BulkEnableChainhooksRequest) (*BulkEnablePreview, error) {
+	if err := validateBulkEnableRequest(request); err != nil {
+		return nil, err
+	}
+
+	it := NewChainhookIterator(ctx, c.getChainhooksUncached, DefaultPageSize)
+	hooks, err := findChainhooks(it, request.Query())
+	if err != nil {
+		return nil, err
+	}
+	return newBulkEnablePreview(request.Enabled, hooks), nil
+}
+
+// newBulkEnablePreview splits the selected chainhooks by whether they are
+// already in the target state.
+func newBulkEnablePreview(enabled bool, hooks []Chainhook) *BulkEnablePreview {
+	preview := &BulkEnablePreview{
+		Enabled:     enabled,
+		WouldChange: []Chainhook{},
+		Unchanged:   []Chainhook{},
+	}
+	for _, hook := range hooks {
+		if hook.Status.Enabled == enabled {
+			preview.Unchanged = append(preview.Unchanged, hook)
+		} else {
+			preview.WouldChange = append(preview.WouldChange, hook)
+		}
+	}
+	return preview
+}
+
+// validateBulkEnableRequest checks that a request selects chainhooks by at
+// least one criterion.
+func validateBulkEnableRequest(request *BulkEnableChainhooksRequest) error {
+	if request == nil {
+		return &ValidationError{
+			Field:  "request",
+			Reason: "request cannot be nil",
+		}
+	}
+
+	// Validate that at least one filter is provided
+	if len(request.UUIDs) == 0 && request.WebhookURL == nil && len(request.Statuses) == 0 {
+		return &ValidationError{
+			Field:  "request",
+			Reason: "at least one filter (uuids, webhook_url, or statuses) must be provided",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package chainhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newBulkServer serves a fixed listing and answers bulk enable requests
+// with updated.
+func newBulkServer(t *testing.T, updated uint64, patches *int) *httptest.Server {
+	t.Helper()
+	hook := func(uuid UUID, url string, status ChainhookStatus, enabled bool) Chainhook {
+		def := testDefinition()
+		def.Action.URL = url
+		return Chainhook{UUID: uuid, Definition: def, Status: ChainhookStatusInfo{Status: status, Enabled: enabled}}
+	}
+	hooks := []Chainhook{
+		hook("uuid-1", "https://example.com/a", ChainhookStatusStreaming, true),
+		hook("uuid-2", "https://example.com/a", ChainhookStatusStreaming, false),
+		hook("uuid-3", "https://example.com/b", ChainhookStatusStreaming, true),
+		hook("uuid-4", "https://example.com/a", ChainhookStatusInterrupted, true),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == EndpointBulkEnabled:
+			*patches++
+			json.NewEncoder(w).Encode(BulkEnableChainhooksResponse{UpdatedCount: updated})
+		case r.Method == http.MethodGet && r.URL.Path == EndpointChainhooks:
+			json.NewEncoder(w).Encode(PaginatedChainhookResponse{Total: uint64(len(hooks)), Limit: DefaultPageSize, Chainhooks: hooks})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func previewUUIDs(hooks []Chainhook) []UUID {
+	uuids := []UUID{}
+	for _, hook := range hooks {
+		uuids = append(uuids, hook.UUID)
+	}
+	return uuids
+}
+
+func TestPreviewBulkEnable(t *testing.T) {
+	var patches int
+	client := NewClientWithConfig(&ClientConfig{BaseURL: newBulkServer(t, 0, &patches).URL})
+
+	tests := []struct {
+		name        string
+		request     *BulkEnableChainhooksRequest
+		wouldChange []UUID
+		unchanged   []UUID
+	}{
+		{"status", BulkEnableByStatus(false, ChainhookStatusStreaming), []UUID{"uuid-1", "uuid-3"}, []UUID{"uuid-2"}},
+		{"webhook", BulkEnableByWebhook(true, "https://example.com/a"), []UUID{"uuid-2"}, []UUID{"uuid-1", "uuid-4"}},
+		{"uuids", BulkEnableUUIDs(false, "uuid-4", "uuid-9"), []UUID{"uuid-4"}, []UUID{}},
+		{
+			"combined",
+			&BulkEnableChainhooksRequest{Enabled: false, WebhookURL: StringPtr("https://example.com/a"), Statuses: []ChainhookStatus{ChainhookStatusStreaming, ChainhookStatusExpired}},
+			[]UUID{"uuid-1"},
+			[]UUID{"uuid-2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preview, err := client.PreviewBulkEnable(context.Background(), tt.request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := previewUUIDs(preview.WouldChange); !equalUUIDs(got, tt.wouldChange) {
+				t.Errorf("would change %v, want %v", got, tt.wouldChange)
+			}
+			if got := previewUUIDs(preview.Unchanged); !equalUUIDs(got, tt.unchanged) {
+				t.Errorf("unchanged %v, want %v", got, tt.unchanged)
+			}
+			if preview.Matched() != len(tt.wouldChange)+len(tt.unchanged) {
+				t.Errorf("matched %d", preview.Matched())
+			}
+		})
+	}
+
+	if patches != 0 {
+		t.Errorf("preview sent %d bulk enable requests", patches)
+	}
+	var verr *ValidationError
+	if _, err := client.PreviewBulkEnable(context.Background(), &BulkEnableChainhooksRequest{}); !errors.As(err, &verr) {
+		t.Errorf("expected validation error for empty selection, got %v", err)
+	}
+}
+
+func TestPreviewBulkEnableBypassesCache(t *testing.T) {
+	var gets int
+	enabled := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gets++
+		hook := Chainhook{UUID: "uuid-1", Definition: testDefinition(), Status: ChainhookStatusInfo{Status: ChainhookStatusStreaming, Enabled: enabled}}
+		json.NewEncoder(w).Encode(PaginatedChainhookResponse{Total: 1, Limit: DefaultPageSize, Chainhooks: []Chainhook{hook}})
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(&ClientConfig{
+		BaseURL:  server.URL,
+		Cache:    NewMemoryCache(),
+		CacheTTL: time.Hour,
+	})
+	ctx := context.Background()
+	opts := NewPaginationOptions(0, DefaultPageSize)
+	if _, err := client.GetChainhooks(ctx, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	// The preview sees the current state rather than the cached listing
+	enabled = false
+	preview, err := client.PreviewBulkEnable(ctx, BulkEnableUUIDs(true, "uuid-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(preview.WouldChange) != 1 {
+		t.Errorf("preview used a stale listing: %+v", preview)
+	}
+
+	// and leaves the cached listing in place
+	if _, err := client.GetChainhooks(ctx, opts); err != nil {
+		t.Fatal(err)
+	}
+	if gets != 2 {
+		t.Errorf("got %d requests, want 2", gets)
+	}
+}
+
+func equalUUIDs(a, b []UUID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestBulkEnableChainhooksExpectedCount(t *testing.T) {
+	request := func(expected uint64) *BulkEnableChainhooksRequest {
+		req := BulkEnableByStatus(false, ChainhookStatusStreaming)
+		req.ExpectedCount = Uint64Ptr(expected)
+		return req
+	}
+
+	t.Run("matching", func(t *testing.T) {
+		var patches int
+		client := NewClientWithConfig(&ClientConfig{BaseURL: newBulkServer(t, 2, &patches).URL})
+		resp, err := client.BulkEnableChainhooks(context.Background(), request(2))
+		if err != nil || resp.UpdatedCount != 2 || patches != 1 {
+			t.Fatalf("got %+v, %v after %d requests", resp, err, patches)
+		}
+	})
+
+	t.Run("preview diverges", func(t *testing.T) {
+		var patches int
+		client := NewClientWithConfig(&ClientConfig{BaseURL: newBulkServer(t, 2, &patches).URL})
+		_, err := client.BulkEnableChainhooks(context.Background(), request(3))
+		var countErr *BulkEnableCountError
+		if !errors.As(err, &countErr) || countErr.Applied || countErr.Actual != 2 {
+			t.Fatalf("expected unapplied count error, got %v", err)
+		}
+		if patches != 0 {
+			t.Fatal("request was sent despite the preview diverging")
+		}
+	})
+
+	// Two streaming hooks are enabled and one is already disabled; a count of
+	// every matched hook is not the expected count.
+	t.Run("server counts matched", func(t *testing.T) {
+		var patches int
+		client := NewClientWithConfig(&ClientConfig{BaseURL: newBulkServer(t, 3, &patches).URL})
+		preview, err := client.PreviewBulkEnable(context.Background(), request(2))
+		if err != nil || preview.Matched() != 3 {
+			t.Fatalf("unexpected preview %+v, %v", preview, err)
+		}
+		resp, err := client.BulkEnableChainhooks(context.Background(), request(2))
+		var countErr *BulkEnableCountError
+		if !errors.As(err, &countErr) || !countErr.Applied || countErr.Expected != 2 || countErr.Actual != 3 {
+			t.Fatalf("expected applied count error, got %v", err)
+		}
+		if resp == nil || patches != 1 {
+			t.Fatalf("expected the server response after one request, got %+v after %d", resp, patches)
+		}
+	})
+
+	t.Run("server diverges", func(t *testing.T) {
+		var patches int
+		client := NewClientWithConfig(&ClientConfig{BaseURL: newBulkServer(t, 5, &patches).URL})
+		resp, err := client.BulkEnableChainhooks(context.Background(), request(2))
+		var countErr *BulkEnableCountError
+		if !errors.As(err, &countErr) || !countErr.Applied || countErr.Actual != 5 {
+			t.Fatalf("expected applied count error, got %v", err)
+		}
+		if resp == nil || resp.UpdatedCount != 5 {
+			t.Fatalf("expected the server response, got %+v", resp)
+		}
+	})
+
+	t.Run("no guard", func(t *testing.T) {
+		var patches int
+		client := NewClientWithConfig(&ClientConfig{BaseURL: newBulkServer(t, 5, &patches).URL})
+		req := BulkEnableByStatus(false, ChainhookStatusStreaming)
+		if _, err := client.BulkEnableChainhooks(context.Background(), req); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,250 @@
+package chainhooks
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ============================================================================
+// Response Cache
+// ============================================================================
+
+// CacheEntry is a cached response body for a chainhook read.
+type CacheEntry struct {
+	// Body is the raw JSON response body.
+	Body []byte
+	// ETag is the entity tag returned by the server, if any.
+	ETag string
+	// Expires is the time after which the entry must be revalidated.
+	Expires time.Time
+}
+
+// Fresh reports whether the entry can be served without contacting the server.
+func (e *CacheEntry) Fresh(now time.Time) bool {
+	return now.Before(e.Expires)
+}
+
+// Cache stores chainhook read responses keyed by request URL.
+//
+// Implementations must be safe for concurrent use. Entries returned by Get
+// must not be modified by the caller. A Cache should not be shared between
+// clients that authenticate as different accounts.
+type Cache interface {
+	// Get returns the entry for key, including stale entries that can still
+	// be revalidated with their ETag.
+	Get(key string) (*CacheEntry, bool)
+	// Set stores the entry for key.
+	Set(key string, entry *CacheEntry)
+	// Delete removes the entry for key.
+	Delete(key string)
+	// DeletePrefix removes every entry whose key starts with prefix.
+	DeletePrefix(prefix string)
+}
+
+// MemoryCache is an in-memory Cache implementation.
+type MemoryCache struct {
+	mu      sync.RWMutex
+	entries map[string]*CacheEntry
+}
+
+// NewMemoryCache creates a new empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: make(map[string]*CacheEntry),
+	}
+}
+
+// Get implements Cache.
+func (m *MemoryCache) Get(key string) (*CacheEntry, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	entry, ok := m.entries[key]
+	return entry, ok
+}
+
+// Set implements Cache.
+func (m *MemoryCache) Set(key string, entry *CacheEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key] = entry
+}
+
+// Delete implements Cache.
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.entries, key)
+}
+
+// DeletePrefix implements Cache.
+func (m *MemoryCache) DeletePrefix(prefix string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key := range m.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(m.entries, key)
+		}
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (m *MemoryCache) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.entries)
+}
+
+// ============================================================================
+// Request Coalescing
+// ============================================================================
+
+// defaultFlightTimeout bounds a coalesced request when the client has no
+// HTTP timeout of its own.
+const defaultFlightTimeout = 30 * time.Second
+
+// flightCall is an in-flight or completed request shared by several callers.
+type flightCall struct {
+	done chan struct{}
+	resp *rawResponse
+	err  error
+}
+
+// flightGroup coalesces concurrent requests with the same key so that only
+// one of them reaches the server.
+type flightGroup struct {
+	mu      sync.Mutex
+	calls   map[string]*flightCall
+	timeout time.Duration
+}
+
+// do runs fn once for all concurrent callers sharing key and returns its
+// result to each of them.
+//
+// The shared call runs detached from the caller that started it, with the
+// group's timeout, so that cancelling one caller does not fail the others.
+// Each caller stops waiting when its own ctx is done.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) (*rawResponse, error)) (*rawResponse, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	call, ok := g.calls[key]
+	if !ok {
+		call = &flightCall{done: make(chan struct{})}
+		g.calls[key] = call
+		go g.run(ctx, key, call, fn)
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.resp, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// run performs the shared call and releases its waiters.
+func (g *flightGroup) run(ctx context.Context, key string, call *flightCall, fn func(ctx context.Context) (*rawResponse, error)) {
+	timeout := g.timeout
+	if timeout <= 0 {
+		timeout = defaultFlightTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	defer cancel()
+
+	call.resp, call.err = fn(ctx)
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+	close(call.done)
+}
+
+// ============================================================================
+// Cache Generations
+// ============================================================================
+
+// cacheGenerations counts invalidations per cache key and per key prefix.
+// A read records the generation of its key before fetching and only stores
+// the response if no invalidation happened in the meantime.
+//
+// Per-key generations are only kept while a read of the key is in flight,
+// so the map does not grow with every chainhook ever invalidated.
+type cacheGenerations struct {
+	mu       sync.Mutex
+	keys     map[string]uint64
+	pending  map[string]int
+	prefixes map[string]uint64
+}
+
+// begin records a read of key and returns its current generation. Every
+// call must be followed by a call to end.
+func (g *cacheGenerations) begin(key string) uint64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.pending == nil {
+		g.pending = make(map[string]int)
+	}
+	g.pending[key]++
+	return g.current(key)
+}
+
+// end finishes a read of key started with begin, dropping the generation
+// of key once no read of it is in flight.
+func (g *cacheGenerations) end(key string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.pending[key]--
+	if g.pending[key] <= 0 {
+		delete(g.pending, key)
+		delete(g.keys, key)
+	}
+}
+
+func (g *cacheGenerations) current(key string) uint64 {
+	gen := g.keys[key]
+	for prefix, n := range g.prefixes {
+		if strings.HasPrefix(key, prefix) {
+			gen += n
+		}
+	}
+	return gen
+}
+
+// store calls set if key is still at generation.
+func (g *cacheGenerations) store(key string, generation uint64, set func()) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.current(key) == generation {
+		set()
+	}
+}
+
+// bump advances the generation of key and calls del. The generation is
+// only recorded if a read of key is in flight.
+func (g *cacheGenerations) bump(key string, del func()) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.pending[key] > 0 {
+		if g.keys == nil {
+			g.keys = make(map[string]uint64)
+		}
+		g.keys[key]++
+	}
+	del()
+}
+
+// bumpPrefix advances the generation of every key starting with prefix and
+// calls del.
+func (g *cacheGenerations) bumpPrefix(prefix string, del func()) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.prefixes == nil {
+		g.prefixes = make(map[string]uint64)
+	}
+	g.prefixes[prefix]++
+	del()
+}
@@ -0,0 +1,231 @@
+package chainhooks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCachedGetChainhookRevalidatesWithETag(t *testing.T) {
+	var hits, notModified int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		if r.Header.Get(HeaderIfNoneMatch) == `"v1"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set(HeaderETag, `"v1"`)
+		w.Write([]byte(`{"uuid":"abc","status":{"status":"streaming","enabled":true}}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(&ClientConfig{
+		BaseURL:  server.URL,
+		Cache:    NewMemoryCache(),
+		CacheTTL: time.Hour,
+	})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		hook, err := client.GetChainhook(ctx, "abc")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hook.UUID != "abc" {
+			t.Fatalf("unexpected uuid %q", hook.UUID)
+		}
+	}
+	if atomic.LoadInt32(&hits) != 1 {
+		t.Fatalf("expected 1 request while fresh, got %d", hits)
+	}
+
+	// Expire the entry and check that it is revalidated rather than refetched
+	client.cacheTTL = 0
+	client.cache.(*MemoryCache).entries[server.URL+"/chainhooks/me/abc"].Expires = time.Time{}
+	hook, err := client.GetChainhook(ctx, "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hook.UUID != "abc" || notModified != 1 {
+		t.Fatalf("expected a 304 revalidation, got uuid %q and %d not-modified responses", hook.UUID, notModified)
+	}
+}
+
+func TestCacheInvalidatedByMutation(t *testing.T) {
+	var gets int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == MethodGET {
+			atomic.AddInt32(&gets, 1)
+			w.Write([]byte(`{"total":0,"offset":0,"limit":10,"chainhooks":[]}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(&ClientConfig{
+		BaseURL:  server.URL,
+		Cache:    NewMemoryCache(),
+		CacheTTL: time.Hour,
+	})
+	ctx := context.Background()
+	opts := NewPaginationOptions(0, 10)
+
+	if _, err := client.GetChainhooks(ctx, opts); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.EnableChainhook(ctx, "abc", false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetChainhooks(ctx, opts); err != nil {
+		t.Fatal(err)
+	}
+	if atomic.LoadInt32(&gets) != 2 {
+		t.Fatalf("expected listing to be refetched after mutation, got %d requests", gets)
+	}
+}
+
+func TestCoalesceRequests(t *testing.T) {
+	var hits int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		<-release
+		w.Write([]byte(`{"uuid":"abc"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(&ClientConfig{
+		BaseURL:          server.URL,
+		CoalesceRequests: true,
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetChainhook(context.Background(), "abc"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+
+	// Give the goroutines time to join the in-flight request
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if atomic.LoadInt32(&hits) != 1 {
+		t.Fatalf("expected 1 coalesced request, got %d", hits)
+	}
+}
+
+func TestCoalesceRequestsSurvivesLeaderCancellation(t *testing.T) {
+	var hits int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		<-release
+		w.Write([]byte(`{"uuid":"abc"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(&ClientConfig{
+		BaseURL:          server.URL,
+		CoalesceRequests: true,
+	})
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := client.GetChainhook(leaderCtx, "abc")
+		leaderErr <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	waiterErr := make(chan error, 1)
+	go func() {
+		_, err := client.GetChainhook(context.Background(), "abc")
+		waiterErr <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	cancel()
+	if err := <-leaderErr; err != context.Canceled {
+		t.Fatalf("expected the leader to see its own cancellation, got %v", err)
+	}
+	close(release)
+	if err := <-waiterErr; err != nil {
+		t.Fatalf("waiter failed after the leader was cancelled: %v", err)
+	}
+	if atomic.LoadInt32(&hits) != 1 {
+		t.Fatalf("expected 1 coalesced request, got %d", hits)
+	}
+}
+
+func TestCacheDropsResponsesFromBeforeInvalidation(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.Write([]byte(`{"uuid":"abc","status":{"status":"streaming","enabled":true}}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(&ClientConfig{
+		BaseURL:  server.URL,
+		Cache:    NewMemoryCache(),
+		CacheTTL: time.Hour,
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.GetChainhook(context.Background(), "abc")
+		done <- err
+	}()
+	<-started
+	client.invalidate("abc")
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := client.cache.Get(server.URL + "/chainhooks/me/abc"); ok {
+		t.Fatal("response fetched before the invalidation was cached")
+	}
+}
+
+func TestCacheGenerationsPruned(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"uuid":"abc","status":{"status":"streaming","enabled":true}}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(&ClientConfig{
+		BaseURL:  server.URL,
+		Cache:    NewMemoryCache(),
+		CacheTTL: time.Hour,
+	})
+
+	for i := 0; i < 100; i++ {
+		uuid := UUID(fmt.Sprintf("hook-%d", i))
+		if _, err := client.GetChainhook(context.Background(), uuid); err != nil {
+			t.Fatal(err)
+		}
+		client.invalidate(uuid)
+	}
+
+	client.generations.mu.Lock()
+	defer client.generations.mu.Unlock()
+	if n := len(client.generations.keys) + len(client.generations.pending); n != 0 {
+		t.Fatalf("expected no per-key generations once reads finished, got %d", n)
+	}
+}
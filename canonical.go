@@ -0,0 +1,202 @@
+package chainhooks
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tony1908/chainhooks-client-go/stacks"
+)
+
+// ============================================================================
+// Canonical Form
+// ============================================================================
+
+// Canonical returns a normalized copy of the definition. Two definitions that
+// differ only in filter order, principal spelling or explicitly-set default
+// options have the same canonical form:
+//
+//   - filters are sorted by event type, then by their canonical JSON encoding
+//   - addresses are re-encoded in canonical upper-case c32check form, and a
+//     contract identifier given as a standard principal becomes a contract
+//     principal
+//   - options set to false are removed, and options with nothing set
+//     become nil
+//
+// The definition itself is not modified.
+func (d *ChainhookDefinition) Canonical() (*ChainhookDefinition, error) {
+	if d == nil {
+		return nil, &ValidationError{
+			Field:  "definition",
+			Reason: "definition cannot be nil",
+		}
+	}
+
+	canonical := *d
+	canonical.Options = canonicalOptions(d.Options)
+
+	type keyedFilter struct {
+		key    string
+		filter EventFilter
+	}
+	keyed := make([]keyedFilter, 0, len(d.Filters.Events))
+	for i, filter := range d.Filters.Events {
+		data, err := canonicalFilterJSON(filter)
+		if err != nil {
+			return nil, fmt.Errorf("filters.events[%d]: %w", i, err)
+		}
+		decoded, err := DecodeEventFilter(data)
+		if err != nil {
+			return nil, fmt.Errorf("filters.events[%d]: %w", i, err)
+		}
+		keyed = append(keyed, keyedFilter{key: string(decoded.EventType()) + "\x00" + string(data), filter: decoded})
+	}
+	sort.SliceStable(keyed, func(i, j int) bool { return keyed[i].key < keyed[j].key })
+
+	canonical.Filters = ChainhookFilters{Events: make([]EventFilter, len(keyed))}
+	for i, k := range keyed {
+		canonical.Filters.Events[i] = k.filter
+	}
+	return &canonical, nil
+}
+
+// CanonicalJSON returns the JSON encoding of the definition's canonical form.
+// The encoding is deterministic, so equal definitions produce equal bytes.
+func (d *ChainhookDefinition) CanonicalJSON() ([]byte, error) {
+	canonical, err := d.Canonical()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(canonical)
+}
+
+// Fingerprint returns the hex-encoded SHA-256 hash of the definition's
+// canonical JSON encoding. It is stable across field order, filter order and
+// default options, so it can be used to detect duplicate chainhooks or as a
+// cache or idempotency key.
+func (d *ChainhookDefinition) Fingerprint() (string, error) {
+	data, err := d.CanonicalJSON()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// canonicalOptions returns a copy of opts without default values, or nil if
+// no option is set.
+func canonicalOptions(opts *ChainhookOptions) *ChainhookOptions {
+	if opts == nil {
+		return nil
+	}
+
+	canonical := *opts
+	for _, field := range []**bool{
+		&canonical.EnableOnRegistration,
+		&canonical.DecodeClarityValues,
+		&canonical.IncludeContractABI,
+		&canonical.IncludeContractSourceCode,
+		&canonical.IncludePostConditions,
+		&canonical.IncludeRawTransactions,
+		&canonical.IncludeBlockSignatures,
+		&canonical.IncludeBlockMetadata,
+	} {
+		if *field != nil && !**field {
+			*field = nil
+		}
+	}
+
+	if canonical == (ChainhookOptions{}) {
+		return nil
+	}
+	return &canonical
+}
+
+// canonicalFilterJSON encodes a filter with sorted keys and normalized
+// principals, contract identifiers and asset identifiers.
+func canonicalFilterJSON(filter EventFilter) ([]byte, error) {
+	if filter == nil {
+		return nil, fmt.Errorf("filter cannot be nil")
+	}
+	data, err := json.Marshal(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var fields map[string]interface{}
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, fmt.Errorf("failed to decode event filter: %w", err)
+	}
+	normalizeFilterFields(fields)
+	return json.Marshal(fields)
+}
+
+// normalizeFilterFields normalizes the addresses in a decoded filter.
+func normalizeFilterFields(fields map[string]interface{}) {
+	for key, value := range fields {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			normalizePrincipal(v)
+		case string:
+			switch key {
+			case "contract_identifier":
+				fields[key] = normalizeContractIdentifier(v)
+			case "asset":
+				contract, name, ok := strings.Cut(v, assetSeparator)
+				if ok {
+					fields[key] = normalizeContractIdentifier(contract) + assetSeparator + name
+				}
+			}
+		}
+	}
+}
+
+// normalizePrincipal normalizes a decoded Principal in place.
+func normalizePrincipal(p map[string]interface{}) {
+	if standard, ok := p["standard"].(string); ok && strings.Contains(standard, ".") {
+		if _, hasContract := p["contract"]; !hasContract {
+			delete(p, "standard")
+			p["contract"] = standard
+		}
+	}
+	if standard, ok := p["standard"].(string); ok {
+		p["standard"] = normalizeAddress(standard)
+	}
+	if contract, ok := p["contract"].(string); ok {
+		p["contract"] = normalizeContractIdentifier(contract)
+	}
+}
+
+// normalizeContractIdentifier normalizes the address part of a contract
+// identifier.
+func normalizeContractIdentifier(id string) string {
+	address, name, ok := strings.Cut(strings.TrimSpace(id), ".")
+	if !ok {
+		return id
+	}
+	return normalizeAddress(address) + "." + name
+}
+
+// normalizeAddress re-encodes a Stacks address in its canonical form. c32
+// decoding is case-insensitive and tolerates the O/0 and I/L/1 confusions,
+// so any spelling that decodes to a valid address is normalized. Addresses
+// that do not decode are returned unchanged.
+func normalizeAddress(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || (s[0] != 'S' && s[0] != 's') {
+		return s
+	}
+	version, data, err := stacks.C32CheckDecode(s[1:])
+	if err != nil || len(data) != 20 {
+		return s
+	}
+	var hash [20]byte
+	copy(hash[:], data)
+	return stacks.NewAddress(version, hash).String()
+}
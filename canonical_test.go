@@ -0,0 +1,126 @@
+package chainhooks
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestCanonicalNilDefinition(t *testing.T) {
+	var def *ChainhookDefinition
+	var verr *ValidationError
+	if _, err := def.Canonical(); !errors.As(err, &verr) || verr.Field != "definition" {
+		t.Fatalf("expected validation error on definition, got %v", err)
+	}
+	if _, err := def.Fingerprint(); !errors.As(err, &verr) {
+		t.Fatalf("expected validation error from Fingerprint, got %v", err)
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	contract := testAddress + ".pool"
+	base := func() *ChainhookDefinition {
+		def := testDefinition()
+		def.Filters = NewChainhookFilters(
+			&STXTransferFilter{Sender: PrincipalStandard(testAddress), Amount: AmountPtr(MicroSTX(100))},
+			&ContractCallFilter{ContractIdentifier: StringPtr(contract), Method: StringPtr("swap")},
+			&FTMintFilter{Asset: AssetIdentifier(contract + "::token"), Recipient: PrincipalContract(contract)},
+		)
+		def.Options = &ChainhookOptions{DecodeClarityValues: BoolPtr(true)}
+		return def
+	}
+	want, err := base().Fingerprint()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lower := strings.ToLower(testAddress)
+	equivalent := []struct {
+		name   string
+		mutate func(*ChainhookDefinition)
+	}{
+		{"reordered filters", func(d *ChainhookDefinition) {
+			e := d.Filters.Events
+			d.Filters = NewChainhookFilters(e[2], e[0], e[1])
+		}},
+		{"address spelling", func(d *ChainhookDefinition) {
+			d.Filters.Events[0] = &STXTransferFilter{Sender: PrincipalStandard(lower), Amount: AmountPtr(MicroSTX(100))}
+			d.Filters.Events[1] = &ContractCallFilter{ContractIdentifier: StringPtr(lower + ".pool"), Method: StringPtr("swap")}
+			d.Filters.Events[2] = &FTMintFilter{Asset: AssetIdentifier(lower + ".pool::token"), Recipient: PrincipalContract(lower + ".pool")}
+		}},
+		{"contract as standard principal", func(d *ChainhookDefinition) {
+			d.Filters.Events[2] = &FTMintFilter{Asset: AssetIdentifier(contract + "::token"), Recipient: PrincipalStandard(contract)}
+		}},
+		{"explicit type", func(d *ChainhookDefinition) {
+			d.Filters.Events[0].(*STXTransferFilter).Type = EventTypeSTXTransfer
+		}},
+		{"default options", func(d *ChainhookDefinition) {
+			d.Options.EnableOnRegistration = BoolPtr(false)
+			d.Options.IncludeRawTransactions = BoolPtr(false)
+		}},
+	}
+	for _, tt := range equivalent {
+		t.Run(tt.name, func(t *testing.T) {
+			def := base()
+			tt.mutate(def)
+			if got, err := def.Fingerprint(); err != nil || got != want {
+				t.Fatalf("got fingerprint %s, %v; want %s", got, err, want)
+			}
+		})
+	}
+
+	different := []struct {
+		name   string
+		mutate func(*ChainhookDefinition)
+	}{
+		{"name", func(d *ChainhookDefinition) { d.Name = "other" }},
+		{"network", func(d *ChainhookDefinition) { d.Network = NetworkTestnet }},
+		{"webhook URL", func(d *ChainhookDefinition) { d.Action.URL = "https://example.com/other" }},
+		{"option enabled", func(d *ChainhookDefinition) { d.Options.IncludeRawTransactions = BoolPtr(true) }},
+		{"option disabled", func(d *ChainhookDefinition) { d.Options = nil }},
+		{"expire count", func(d *ChainhookDefinition) { d.Options.ExpireAfterOccurrences = Uint64Ptr(1) }},
+		{"amount", func(d *ChainhookDefinition) {
+			d.Filters.Events[0].(*STXTransferFilter).Amount = AmountPtr(MicroSTX(101))
+		}},
+		{"principal role", func(d *ChainhookDefinition) {
+			d.Filters.Events[0] = &STXTransferFilter{Recipient: PrincipalStandard(testAddress), Amount: AmountPtr(MicroSTX(100))}
+		}},
+		{"duplicate filter", func(d *ChainhookDefinition) {
+			d.Filters = NewChainhookFilters(append(d.Filters.Events, &ContractCallFilter{ContractIdentifier: StringPtr(contract), Method: StringPtr("swap")})...)
+		}},
+		{"removed filter", func(d *ChainhookDefinition) { d.Filters = NewChainhookFilters(d.Filters.Events[1:]...) }},
+	}
+	for _, tt := range different {
+		t.Run(tt.name, func(t *testing.T) {
+			def := base()
+			tt.mutate(def)
+			if got, err := def.Fingerprint(); err != nil || got == want {
+				t.Fatalf("got fingerprint %s, %v; want a different one", got, err)
+			}
+		})
+	}
+}
+
+func TestFingerprintStable(t *testing.T) {
+	def := testDefinition()
+	// Fingerprints are stored as deduplication keys, so the encoding they
+	// hash must not change between releases.
+	const want = "9a88f16aa4cf99cecc04bdb294c549a05f8ee671afe7d382934e971f5ae85d13"
+	got, err := def.Fingerprint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Fatalf("fingerprint changed to %s", got)
+	}
+
+	// Computing the canonical form does not modify the definition
+	before := fmt.Sprintf("%+v", def.Filters.Events[0])
+	if _, err := def.Canonical(); err != nil {
+		t.Fatal(err)
+	}
+	if after := fmt.Sprintf("%+v", def.Filters.Events[0]); after != before {
+		t.Fatalf("Canonical modified the definition: %s", after)
+	}
+}
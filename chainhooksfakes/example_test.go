@@ -0,0 +1,39 @@
+package chainhooksfakes_test
+
+import (
+	"context"
+	"fmt"
+
+	chainhooks "github.com/tony1908/chainhooks-client-go"
+	"github.com/tony1908/chainhooks-client-go/chainhooksfakes"
+)
+
+// disableHook is code under test that depends on the API interface.
+func disableHook(ctx context.Context, api chainhooks.ChainhooksAPI, uuid chainhooks.UUID) error {
+	hook, err := api.GetChainhook(ctx, uuid)
+	if err != nil {
+		return err
+	}
+	if !hook.Status.Enabled {
+		return nil
+	}
+	return api.EnableChainhook(ctx, uuid, false)
+}
+
+// ExampleFakeChainhooksAPI demonstrates programming and inspecting the fake.
+func ExampleFakeChainhooksAPI() {
+	fake := &chainhooksfakes.FakeChainhooksAPI{}
+	fake.GetChainhookReturns(&chainhooks.Chainhook{
+		UUID:   "uuid-1",
+		Status: chainhooks.ChainhookStatusInfo{Enabled: true},
+	}, nil)
+
+	if err := disableHook(context.Background(), fake, "uuid-1"); err != nil {
+		panic(err)
+	}
+
+	_, uuid, enabled := fake.EnableChainhookArgsForCall(0)
+	fmt.Println(fake.EnableChainhookCallCount(), uuid, enabled)
+	// Output:
+	// 1 uuid-1 false
+}
@@ -0,0 +1,1731 @@
+// Package chainhooksfakes provides a configurable fake implementation of
+// chainhooks.ChainhooksAPI for use in tests.
+//
+// Each method records its arguments and returns, in order of precedence, the
+// result of its Stub function, the values programmed for that specific call
+// with <Method>ReturnsOnCall, or the values programmed with <Method>Returns.
+// AllChainhooks with no programmed iterator returns one that lists pages
+// through the fake's GetChainhooks.
+package chainhooksfakes
+
+import (
+	"context"
+	"sync"
+
+	chainhooks "github.com/tony1908/chainhooks-client-go"
+)
+
+// FakeChainhooksAPI is a fake implementation of chainhooks.ChainhooksAPI.
+type FakeChainhooksAPI struct {
+	RegisterChainhookStub        func(context.Context, *chainhooks.ChainhookDefinition) (*chainhooks.Chainhook, error)
+	registerChainhookMutex       sync.RWMutex
+	registerChainhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 *chainhooks.ChainhookDefinition
+	}
+	registerChainhookReturns struct {
+		result1 *chainhooks.Chainhook
+		result2 error
+	}
+	registerChainhookReturnsOnCall map[int]struct {
+		result1 *chainhooks.Chainhook
+		result2 error
+	}
+	UpdateChainhookStub        func(context.Context, chainhooks.UUID, *chainhooks.ChainhookDefinition) (*chainhooks.Chainhook, error)
+	updateChainhookMutex       sync.RWMutex
+	updateChainhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 chainhooks.UUID
+		arg3 *chainhooks.ChainhookDefinition
+	}
+	updateChainhookReturns struct {
+		result1 *chainhooks.Chainhook
+		result2 error
+	}
+	updateChainhookReturnsOnCall map[int]struct {
+		result1 *chainhooks.Chainhook
+		result2 error
+	}
+	PatchChainhookStub        func(context.Context, chainhooks.UUID, *chainhooks.ChainhookPatch) (*chainhooks.Chainhook, error)
+	patchChainhookMutex       sync.RWMutex
+	patchChainhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 chainhooks.UUID
+		arg3 *chainhooks.ChainhookPatch
+	}
+	patchChainhookReturns struct {
+		result1 *chainhooks.Chainhook
+		result2 error
+	}
+	patchChainhookReturnsOnCall map[int]struct {
+		result1 *chainhooks.Chainhook
+		result2 error
+	}
+	GetChainhooksStub        func(context.Context, *chainhooks.PaginationOptions) (*chainhooks.PaginatedChainhookResponse, error)
+	getChainhooksMutex       sync.RWMutex
+	getChainhooksArgsForCall []struct {
+		arg1 context.Context
+		arg2 *chainhooks.PaginationOptions
+	}
+	getChainhooksReturns struct {
+		result1 *chainhooks.PaginatedChainhookResponse
+		result2 error
+	}
+	getChainhooksReturnsOnCall map[int]struct {
+		result1 *chainhooks.PaginatedChainhookResponse
+		result2 error
+	}
+	GetChainhookStub        func(context.Context, chainhooks.UUID) (*chainhooks.Chainhook, error)
+	getChainhookMutex       sync.RWMutex
+	getChainhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 chainhooks.UUID
+	}
+	getChainhookReturns struct {
+		result1 *chainhooks.Chainhook
+		result2 error
+	}
+	getChainhookReturnsOnCall map[int]struct {
+		result1 *chainhooks.Chainhook
+		result2 error
+	}
+	EnableChainhookStub        func(context.Context, chainhooks.UUID, bool) error
+	enableChainhookMutex       sync.RWMutex
+	enableChainhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 chainhooks.UUID
+		arg3 bool
+	}
+	enableChainhookReturns struct {
+		result1 error
+	}
+	enableChainhookReturnsOnCall map[int]struct {
+		result1 error
+	}
+	BulkEnableChainhooksStub        func(context.Context, *chainhooks.BulkEnableChainhooksRequest) (*chainhooks.BulkEnableChainhooksResponse, error)
+	bulkEnableChainhooksMutex       sync.RWMutex
+	bulkEnableChainhooksArgsForCall []struct {
+		arg1 context.Context
+		arg2 *chainhooks.BulkEnableChainhooksRequest
+	}
+	bulkEnableChainhooksReturns struct {
+		result1 *chainhooks.BulkEnableChainhooksResponse
+		result2 error
+	}
+	bulkEnableChainhooksReturnsOnCall map[int]struct {
+		result1 *chainhooks.BulkEnableChainhooksResponse
+		result2 error
+	}
+	DeleteChainhookStub        func(context.Context, chainhooks.UUID) error
+	deleteChainhookMutex       sync.RWMutex
+	deleteChainhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 chainhooks.UUID
+	}
+	deleteChainhookReturns struct {
+		result1 error
+	}
+	deleteChainhookReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RotateConsumerSecretStub        func(context.Context) (*chainhooks.ConsumerSecretResponse, error)
+	rotateConsumerSecretMutex       sync.RWMutex
+	rotateConsumerSecretArgsForCall []struct {
+		arg1 context.Context
+	}
+	rotateConsumerSecretReturns struct {
+		result1 *chainhooks.ConsumerSecretResponse
+		result2 error
+	}
+	rotateConsumerSecretReturnsOnCall map[int]struct {
+		result1 *chainhooks.ConsumerSecretResponse
+		result2 error
+	}
+	GetConsumerSecretStub        func(context.Context) (*chainhooks.ConsumerSecretResponse, error)
+	getConsumerSecretMutex       sync.RWMutex
+	getConsumerSecretArgsForCall []struct {
+		arg1 context.Context
+	}
+	getConsumerSecretReturns struct {
+		result1 *chainhooks.ConsumerSecretResponse
+		result2 error
+	}
+	getConsumerSecretReturnsOnCall map[int]struct {
+		result1 *chainhooks.ConsumerSecretResponse
+		result2 error
+	}
+	DeleteConsumerSecretStub        func(context.Context) error
+	deleteConsumerSecretMutex       sync.RWMutex
+	deleteConsumerSecretArgsForCall []struct {
+		arg1 context.Context
+	}
+	deleteConsumerSecretReturns struct {
+		result1 error
+	}
+	deleteConsumerSecretReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EvaluateChainhookStub        func(context.Context, chainhooks.UUID, uint64) error
+	evaluateChainhookMutex       sync.RWMutex
+	evaluateChainhookArgsForCall []struct {
+		arg1 context.Context
+		arg2 chainhooks.UUID
+		arg3 uint64
+	}
+	evaluateChainhookReturns struct {
+		result1 error
+	}
+	evaluateChainhookReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetStatusStub        func(context.Context) (*chainhooks.ApiStatusResponse, error)
+	getStatusMutex       sync.RWMutex
+	getStatusArgsForCall []struct {
+		arg1 context.Context
+	}
+	getStatusReturns struct {
+		result1 *chainhooks.ApiStatusResponse
+		result2 error
+	}
+	getStatusReturnsOnCall map[int]struct {
+		result1 *chainhooks.ApiStatusResponse
+		result2 error
+	}
+	AllChainhooksStub        func(context.Context, uint64) *chainhooks.ChainhookIterator
+	allChainhooksMutex       sync.RWMutex
+	allChainhooksArgsForCall []struct {
+		arg1 context.Context
+		arg2 uint64
+	}
+	allChainhooksReturns struct {
+		result1 *chainhooks.ChainhookIterator
+	}
+	allChainhooksReturnsOnCall map[int]struct {
+		result1 *chainhooks.ChainhookIterator
+	}
+	FindChainhooksStub        func(context.Context, *chainhooks.ChainhookQuery) ([]chainhooks.Chainhook, error)
+	findChainhooksMutex       sync.RWMutex
+	findChainhooksArgsForCall []struct {
+		arg1 context.Context
+		arg2 *chainhooks.ChainhookQuery
+	}
+	findChainhooksReturns struct {
+		result1 []chainhooks.Chainhook
+		result2 error
+	}
+	findChainhooksReturnsOnCall map[int]struct {
+		result1 []chainhooks.Chainhook
+		result2 error
+	}
+	FindByNameStub        func(context.Context, string) ([]chainhooks.Chainhook, error)
+	findByNameMutex       sync.RWMutex
+	findByNameArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	findByNameReturns struct {
+		result1 []chainhooks.Chainhook
+		result2 error
+	}
+	findByNameReturnsOnCall map[int]struct {
+		result1 []chainhooks.Chainhook
+		result2 error
+	}
+	FindByWebhookURLStub        func(context.Context, string) ([]chainhooks.Chainhook, error)
+	findByWebhookURLMutex       sync.RWMutex
+	findByWebhookURLArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	findByWebhookURLReturns struct {
+		result1 []chainhooks.Chainhook
+		result2 error
+	}
+	findByWebhookURLReturnsOnCall map[int]struct {
+		result1 []chainhooks.Chainhook
+		result2 error
+	}
+	ListChainhooksParallelStub        func(context.Context, uint64) ([]chainhooks.Chainhook, error)
+	listChainhooksParallelMutex       sync.RWMutex
+	listChainhooksParallelArgsForCall []struct {
+		arg1 context.Context
+		arg2 uint64
+	}
+	listChainhooksParallelReturns struct {
+		result1 []chainhooks.Chainhook
+		result2 error
+	}
+	listChainhooksParallelReturnsOnCall map[int]struct {
+		result1 []chainhooks.Chainhook
+		result2 error
+	}
+	GetChainhooksByUUIDStub        func(context.Context, []chainhooks.UUID) ([]chainhooks.ChainhookResult, error)
+	getChainhooksByUUIDMutex       sync.RWMutex
+	getChainhooksByUUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 []chainhooks.UUID
+	}
+	getChainhooksByUUIDReturns struct {
+		result1 []chainhooks.ChainhookResult
+		result2 error
+	}
+	getChainhooksByUUIDReturnsOnCall map[int]struct {
+		result1 []chainhooks.ChainhookResult
+		result2 error
+	}
+	PreviewBulkEnableStub        func(context.Context, *chainhooks.BulkEnableChainhooksRequest) (*chainhooks.BulkEnablePreview, error)
+	previewBulkEnableMutex       sync.RWMutex
+	previewBulkEnableArgsForCall []struct {
+		arg1 context.Context
+		arg2 *chainhooks.BulkEnableChainhooksRequest
+	}
+	previewBulkEnableReturns struct {
+		result1 *chainhooks.BulkEnablePreview
+		result2 error
+	}
+	previewBulkEnableReturnsOnCall map[int]struct {
+		result1 *chainhooks.BulkEnablePreview
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+// RegisterChainhook implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) RegisterChainhook(arg1 context.Context, arg2 *chainhooks.ChainhookDefinition) (*chainhooks.Chainhook, error) {
+	fake.registerChainhookMutex.Lock()
+	ret, specificReturn := fake.registerChainhookReturnsOnCall[len(fake.registerChainhookArgsForCall)]
+	fake.registerChainhookArgsForCall = append(fake.registerChainhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 *chainhooks.ChainhookDefinition
+	}{arg1, arg2})
+	stub := fake.RegisterChainhookStub
+	fakeReturns := fake.registerChainhookReturns
+	fake.recordInvocation("RegisterChainhook", []interface{}{arg1, arg2})
+	fake.registerChainhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+// RegisterChainhookCallCount returns the number of times RegisterChainhook has been called.
+func (fake *FakeChainhooksAPI) RegisterChainhookCallCount() int {
+	fake.registerChainhookMutex.RLock()
+	defer fake.registerChainhookMutex.RUnlock()
+	return len(fake.registerChainhookArgsForCall)
+}
+
+// RegisterChainhookCalls sets a stub that computes the results of RegisterChainhook.
+func (fake *FakeChainhooksAPI) RegisterChainhookCalls(stub func(context.Context, *chainhooks.ChainhookDefinition) (*chainhooks.Chainhook, error)) {
+	fake.registerChainhookMutex.Lock()
+	defer fake.registerChainhookMutex.Unlock()
+	fake.RegisterChainhookStub = stub
+}
+
+// RegisterChainhookArgsForCall returns the arguments of the i-th call to RegisterChainhook.
+func (fake *FakeChainhooksAPI) RegisterChainhookArgsForCall(i int) (context.Context, *chainhooks.ChainhookDefinition) {
+	fake.registerChainhookMutex.RLock()
+	defer fake.registerChainhookMutex.RUnlock()
+	argsForCall := fake.registerChainhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+// RegisterChainhookReturns programs the results returned by every call to RegisterChainhook.
+func (fake *FakeChainhooksAPI) RegisterChainhookReturns(result1 *chainhooks.Chainhook, result2 error) {
+	fake.registerChainhookMutex.Lock()
+	defer fake.registerChainhookMutex.Unlock()
+	fake.RegisterChainhookStub = nil
+	fake.registerChainhookReturns = struct {
+		result1 *chainhooks.Chainhook
+		result2 error
+	}{result1, result2}
+}
+
+// RegisterChainhookReturnsOnCall programs the results returned by the i-th call to RegisterChainhook.
+func (fake *FakeChainhooksAPI) RegisterChainhookReturnsOnCall(i int, result1 *chainhooks.Chainhook, result2 error) {
+	fake.registerChainhookMutex.Lock()
+	defer fake.registerChainhookMutex.Unlock()
+	fake.RegisterChainhookStub = nil
+	if fake.registerChainhookReturnsOnCall == nil {
+		fake.registerChainhookReturnsOnCall = make(map[int]struct {
+			result1 *chainhooks.Chainhook
+			result2 error
+		})
+	}
+	fake.registerChainhookReturnsOnCall[i] = struct {
+		result1 *chainhooks.Chainhook
+		result2 error
+	}{result1, result2}
+}
+
+// UpdateChainhook implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) UpdateChainhook(arg1 context.Context, arg2 chainhooks.UUID, arg3 *chainhooks.ChainhookDefinition) (*chainhooks.Chainhook, error) {
+	fake.updateChainhookMutex.Lock()
+	ret, specificReturn := fake.updateChainhookReturnsOnCall[len(fake.updateChainhookArgsForCall)]
+	fake.updateChainhookArgsForCall = append(fake.updateChainhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 chainhooks.UUID
+		arg3 *chainhooks.ChainhookDefinition
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateChainhookStub
+	fakeReturns := fake.updateChainhookReturns
+	fake.recordInvocation("UpdateChainhook", []interface{}{arg1, arg2, arg3})
+	fake.updateChainhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+// UpdateChainhookCallCount returns the number of times UpdateChainhook has been called.
+func (fake *FakeChainhooksAPI) UpdateChainhookCallCount() int {
+	fake.updateChainhookMutex.RLock()
+	defer fake.updateChainhookMutex.RUnlock()
+	return len(fake.updateChainhookArgsForCall)
+}
+
+// UpdateChainhookCalls sets a stub that computes the results of UpdateChainhook.
+func (fake *FakeChainhooksAPI) UpdateChainhookCalls(stub func(context.Context, chainhooks.UUID, *chainhooks.ChainhookDefinition) (*chainhooks.Chainhook, error)) {
+	fake.updateChainhookMutex.Lock()
+	defer fake.updateChainhookMutex.Unlock()
+	fake.UpdateChainhookStub = stub
+}
+
+// UpdateChainhookArgsForCall returns the arguments of the i-th call to UpdateChainhook.
+func (fake *FakeChainhooksAPI) UpdateChainhookArgsForCall(i int) (context.Context, chainhooks.UUID, *chainhooks.ChainhookDefinition) {
+	fake.updateChainhookMutex.RLock()
+	defer fake.updateChainhookMutex.RUnlock()
+	argsForCall := fake.updateChainhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+// UpdateChainhookReturns programs the results returned by every call to UpdateChainhook.
+func (fake *FakeChainhooksAPI) UpdateChainhookReturns(result1 *chainhooks.Chainhook, result2 error) {
+	fake.updateChainhookMutex.Lock()
+	defer fake.updateChainhookMutex.Unlock()
+	fake.UpdateChainhookStub = nil
+	fake.updateChainhookReturns = struct {
+		result1 *chainhooks.Chainhook
+		result2 error
+	}{result1, result2}
+}
+
+// UpdateChainhookReturnsOnCall programs the results returned by the i-th call to UpdateChainhook.
+func (fake *FakeChainhooksAPI) UpdateChainhookReturnsOnCall(i int, result1 *chainhooks.Chainhook, result2 error) {
+	fake.updateChainhookMutex.Lock()
+	defer fake.updateChainhookMutex.Unlock()
+	fake.UpdateChainhookStub = nil
+	if fake.updateChainhookReturnsOnCall == nil {
+		fake.updateChainhookReturnsOnCall = make(map[int]struct {
+			result1 *chainhooks.Chainhook
+			result2 error
+		})
+	}
+	fake.updateChainhookReturnsOnCall[i] = struct {
+		result1 *chainhooks.Chainhook
+		result2 error
+	}{result1, result2}
+}
+
+// PatchChainhook implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) PatchChainhook(arg1 context.Context, arg2 chainhooks.UUID, arg3 *chainhooks.ChainhookPatch) (*chainhooks.Chainhook, error) {
+	fake.patchChainhookMutex.Lock()
+	ret, specificReturn := fake.patchChainhookReturnsOnCall[len(fake.patchChainhookArgsForCall)]
+	fake.patchChainhookArgsForCall = append(fake.patchChainhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 chainhooks.UUID
+		arg3 *chainhooks.ChainhookPatch
+	}{arg1, arg2, arg3})
+	stub := fake.PatchChainhookStub
+	fakeReturns := fake.patchChainhookReturns
+	fake.recordInvocation("PatchChainhook", []interface{}{arg1, arg2, arg3})
+	fake.patchChainhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+// PatchChainhookCallCount returns the number of times PatchChainhook has been called.
+func (fake *FakeChainhooksAPI) PatchChainhookCallCount() int {
+	fake.patchChainhookMutex.RLock()
+	defer fake.patchChainhookMutex.RUnlock()
+	return len(fake.patchChainhookArgsForCall)
+}
+
+// PatchChainhookCalls sets a stub that computes the results of PatchChainhook.
+func (fake *FakeChainhooksAPI) PatchChainhookCalls(stub func(context.Context, chainhooks.UUID, *chainhooks.ChainhookPatch) (*chainhooks.Chainhook, error)) {
+	fake.patchChainhookMutex.Lock()
+	defer fake.patchChainhookMutex.Unlock()
+	fake.PatchChainhookStub = stub
+}
+
+// PatchChainhookArgsForCall returns the arguments of the i-th call to PatchChainhook.
+func (fake *FakeChainhooksAPI) PatchChainhookArgsForCall(i int) (context.Context, chainhooks.UUID, *chainhooks.ChainhookPatch) {
+	fake.patchChainhookMutex.RLock()
+	defer fake.patchChainhookMutex.RUnlock()
+	argsForCall := fake.patchChainhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+// PatchChainhookReturns programs the results returned by every call to PatchChainhook.
+func (fake *FakeChainhooksAPI) PatchChainhookReturns(result1 *chainhooks.Chainhook, result2 error) {
+	fake.patchChainhookMutex.Lock()
+	defer fake.patchChainhookMutex.Unlock()
+	fake.PatchChainhookStub = nil
+	fake.patchChainhookReturns = struct {
+		result1 *chainhooks.Chainhook
+		result2 error
+	}{result1, result2}
+}
+
+// PatchChainhookReturnsOnCall programs the results returned by the i-th call to PatchChainhook.
+func (fake *FakeChainhooksAPI) PatchChainhookReturnsOnCall(i int, result1 *chainhooks.Chainhook, result2 error) {
+	fake.patchChainhookMutex.Lock()
+	defer fake.patchChainhookMutex.Unlock()
+	fake.PatchChainhookStub = nil
+	if fake.patchChainhookReturnsOnCall == nil {
+		fake.patchChainhookReturnsOnCall = make(map[int]struct {
+			result1 *chainhooks.Chainhook
+			result2 error
+		})
+	}
+	fake.patchChainhookReturnsOnCall[i] = struct {
+		result1 *chainhooks.Chainhook
+		result2 error
+	}{result1, result2}
+}
+
+// GetChainhooks implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) GetChainhooks(arg1 context.Context, arg2 *chainhooks.PaginationOptions) (*chainhooks.PaginatedChainhookResponse, error) {
+	fake.getChainhooksMutex.Lock()
+	ret, specificReturn := fake.getChainhooksReturnsOnCall[len(fake.getChainhooksArgsForCall)]
+	fake.getChainhooksArgsForCall = append(fake.getChainhooksArgsForCall, struct {
+		arg1 context.Context
+		arg2 *chainhooks.PaginationOptions
+	}{arg1, arg2})
+	stub := fake.GetChainhooksStub
+	fakeReturns := fake.getChainhooksReturns
+	fake.recordInvocation("GetChainhooks", []interface{}{arg1, arg2})
+	fake.getChainhooksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+// GetChainhooksCallCount returns the number of times GetChainhooks has been called.
+func (fake *FakeChainhooksAPI) GetChainhooksCallCount() int {
+	fake.getChainhooksMutex.RLock()
+	defer fake.getChainhooksMutex.RUnlock()
+	return len(fake.getChainhooksArgsForCall)
+}
+
+// GetChainhooksCalls sets a stub that computes the results of GetChainhooks.
+func (fake *FakeChainhooksAPI) GetChainhooksCalls(stub func(context.Context, *chainhooks.PaginationOptions) (*chainhooks.PaginatedChainhookResponse, error)) {
+	fake.getChainhooksMutex.Lock()
+	defer fake.getChainhooksMutex.Unlock()
+	fake.GetChainhooksStub = stub
+}
+
+// GetChainhooksArgsForCall returns the arguments of the i-th call to GetChainhooks.
+func (fake *FakeChainhooksAPI) GetChainhooksArgsForCall(i int) (context.Context, *chainhooks.PaginationOptions) {
+	fake.getChainhooksMutex.RLock()
+	defer fake.getChainhooksMutex.RUnlock()
+	argsForCall := fake.getChainhooksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+// GetChainhooksReturns programs the results returned by every call to GetChainhooks.
+func (fake *FakeChainhooksAPI) GetChainhooksReturns(result1 *chainhooks.PaginatedChainhookResponse, result2 error) {
+	fake.getChainhooksMutex.Lock()
+	defer fake.getChainhooksMutex.Unlock()
+	fake.GetChainhooksStub = nil
+	fake.getChainhooksReturns = struct {
+		result1 *chainhooks.PaginatedChainhookResponse
+		result2 error
+	}{result1, result2}
+}
+
+// GetChainhooksReturnsOnCall programs the results returned by the i-th call to GetChainhooks.
+func (fake *FakeChainhooksAPI) GetChainhooksReturnsOnCall(i int, result1 *chainhooks.PaginatedChainhookResponse, result2 error) {
+	fake.getChainhooksMutex.Lock()
+	defer fake.getChainhooksMutex.Unlock()
+	fake.GetChainhooksStub = nil
+	if fake.getChainhooksReturnsOnCall == nil {
+		fake.getChainhooksReturnsOnCall = make(map[int]struct {
+			result1 *chainhooks.PaginatedChainhookResponse
+			result2 error
+		})
+	}
+	fake.getChainhooksReturnsOnCall[i] = struct {
+		result1 *chainhooks.PaginatedChainhookResponse
+		result2 error
+	}{result1, result2}
+}
+
+// GetChainhook implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) GetChainhook(arg1 context.Context, arg2 chainhooks.UUID) (*chainhooks.Chainhook, error) {
+	fake.getChainhookMutex.Lock()
+	ret, specificReturn := fake.getChainhookReturnsOnCall[len(fake.getChainhookArgsForCall)]
+	fake.getChainhookArgsForCall = append(fake.getChainhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 chainhooks.UUID
+	}{arg1, arg2})
+	stub := fake.GetChainhookStub
+	fakeReturns := fake.getChainhookReturns
+	fake.recordInvocation("GetChainhook", []interface{}{arg1, arg2})
+	fake.getChainhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+// GetChainhookCallCount returns the number of times GetChainhook has been called.
+func (fake *FakeChainhooksAPI) GetChainhookCallCount() int {
+	fake.getChainhookMutex.RLock()
+	defer fake.getChainhookMutex.RUnlock()
+	return len(fake.getChainhookArgsForCall)
+}
+
+// GetChainhookCalls sets a stub that computes the results of GetChainhook.
+func (fake *FakeChainhooksAPI) GetChainhookCalls(stub func(context.Context, chainhooks.UUID) (*chainhooks.Chainhook, error)) {
+	fake.getChainhookMutex.Lock()
+	defer fake.getChainhookMutex.Unlock()
+	fake.GetChainhookStub = stub
+}
+
+// GetChainhookArgsForCall returns the arguments of the i-th call to GetChainhook.
+func (fake *FakeChainhooksAPI) GetChainhookArgsForCall(i int) (context.Context, chainhooks.UUID) {
+	fake.getChainhookMutex.RLock()
+	defer fake.getChainhookMutex.RUnlock()
+	argsForCall := fake.getChainhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+// GetChainhookReturns programs the results returned by every call to GetChainhook.
+func (fake *FakeChainhooksAPI) GetChainhookReturns(result1 *chainhooks.Chainhook, result2 error) {
+	fake.getChainhookMutex.Lock()
+	defer fake.getChainhookMutex.Unlock()
+	fake.GetChainhookStub = nil
+	fake.getChainhookReturns = struct {
+		result1 *chainhooks.Chainhook
+		result2 error
+	}{result1, result2}
+}
+
+// GetChainhookReturnsOnCall programs the results returned by the i-th call to GetChainhook.
+func (fake *FakeChainhooksAPI) GetChainhookReturnsOnCall(i int, result1 *chainhooks.Chainhook, result2 error) {
+	fake.getChainhookMutex.Lock()
+	defer fake.getChainhookMutex.Unlock()
+	fake.GetChainhookStub = nil
+	if fake.getChainhookReturnsOnCall == nil {
+		fake.getChainhookReturnsOnCall = make(map[int]struct {
+			result1 *chainhooks.Chainhook
+			result2 error
+		})
+	}
+	fake.getChainhookReturnsOnCall[i] = struct {
+		result1 *chainhooks.Chainhook
+		result2 error
+	}{result1, result2}
+}
+
+// EnableChainhook implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) EnableChainhook(arg1 context.Context, arg2 chainhooks.UUID, arg3 bool) error {
+	fake.enableChainhookMutex.Lock()
+	ret, specificReturn := fake.enableChainhookReturnsOnCall[len(fake.enableChainhookArgsForCall)]
+	fake.enableChainhookArgsForCall = append(fake.enableChainhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 chainhooks.UUID
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.EnableChainhookStub
+	fakeReturns := fake.enableChainhookReturns
+	fake.recordInvocation("EnableChainhook", []interface{}{arg1, arg2, arg3})
+	fake.enableChainhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+// EnableChainhookCallCount returns the number of times EnableChainhook has been called.
+func (fake *FakeChainhooksAPI) EnableChainhookCallCount() int {
+	fake.enableChainhookMutex.RLock()
+	defer fake.enableChainhookMutex.RUnlock()
+	return len(fake.enableChainhookArgsForCall)
+}
+
+// EnableChainhookCalls sets a stub that computes the results of EnableChainhook.
+func (fake *FakeChainhooksAPI) EnableChainhookCalls(stub func(context.Context, chainhooks.UUID, bool) error) {
+	fake.enableChainhookMutex.Lock()
+	defer fake.enableChainhookMutex.Unlock()
+	fake.EnableChainhookStub = stub
+}
+
+// EnableChainhookArgsForCall returns the arguments of the i-th call to EnableChainhook.
+func (fake *FakeChainhooksAPI) EnableChainhookArgsForCall(i int) (context.Context, chainhooks.UUID, bool) {
+	fake.enableChainhookMutex.RLock()
+	defer fake.enableChainhookMutex.RUnlock()
+	argsForCall := fake.enableChainhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+// EnableChainhookReturns programs the results returned by every call to EnableChainhook.
+func (fake *FakeChainhooksAPI) EnableChainhookReturns(result1 error) {
+	fake.enableChainhookMutex.Lock()
+	defer fake.enableChainhookMutex.Unlock()
+	fake.EnableChainhookStub = nil
+	fake.enableChainhookReturns = struct {
+		result1 error
+	}{result1}
+}
+
+// EnableChainhookReturnsOnCall programs the results returned by the i-th call to EnableChainhook.
+func (fake *FakeChainhooksAPI) EnableChainhookReturnsOnCall(i int, result1 error) {
+	fake.enableChainhookMutex.Lock()
+	defer fake.enableChainhookMutex.Unlock()
+	fake.EnableChainhookStub = nil
+	if fake.enableChainhookReturnsOnCall == nil {
+		fake.enableChainhookReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.enableChainhookReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+// BulkEnableChainhooks implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) BulkEnableChainhooks(arg1 context.Context, arg2 *chainhooks.BulkEnableChainhooksRequest) (*chainhooks.BulkEnableChainhooksResponse, error) {
+	fake.bulkEnableChainhooksMutex.Lock()
+	ret, specificReturn := fake.bulkEnableChainhooksReturnsOnCall[len(fake.bulkEnableChainhooksArgsForCall)]
+	fake.bulkEnableChainhooksArgsForCall = append(fake.bulkEnableChainhooksArgsForCall, struct {
+		arg1 context.Context
+		arg2 *chainhooks.BulkEnableChainhooksRequest
+	}{arg1, arg2})
+	stub := fake.BulkEnableChainhooksStub
+	fakeReturns := fake.bulkEnableChainhooksReturns
+	fake.recordInvocation("BulkEnableChainhooks", []interface{}{arg1, arg2})
+	fake.bulkEnableChainhooksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+// BulkEnableChainhooksCallCount returns the number of times BulkEnableChainhooks has been called.
+func (fake *FakeChainhooksAPI) BulkEnableChainhooksCallCount() int {
+	fake.bulkEnableChainhooksMutex.RLock()
+	defer fake.bulkEnableChainhooksMutex.RUnlock()
+	return len(fake.bulkEnableChainhooksArgsForCall)
+}
+
+// BulkEnableChainhooksCalls sets a stub that computes the results of BulkEnableChainhooks.
+func (fake *FakeChainhooksAPI) BulkEnableChainhooksCalls(stub func(context.Context, *chainhooks.BulkEnableChainhooksRequest) (*chainhooks.BulkEnableChainhooksResponse, error)) {
+	fake.bulkEnableChainhooksMutex.Lock()
+	defer fake.bulkEnableChainhooksMutex.Unlock()
+	fake.BulkEnableChainhooksStub = stub
+}
+
+// BulkEnableChainhooksArgsForCall returns the arguments of the i-th call to BulkEnableChainhooks.
+func (fake *FakeChainhooksAPI) BulkEnableChainhooksArgsForCall(i int) (context.Context, *chainhooks.BulkEnableChainhooksRequest) {
+	fake.bulkEnableChainhooksMutex.RLock()
+	defer fake.bulkEnableChainhooksMutex.RUnlock()
+	argsForCall := fake.bulkEnableChainhooksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+// BulkEnableChainhooksReturns programs the results returned by every call to BulkEnableChainhooks.
+func (fake *FakeChainhooksAPI) BulkEnableChainhooksReturns(result1 *chainhooks.BulkEnableChainhooksResponse, result2 error) {
+	fake.bulkEnableChainhooksMutex.Lock()
+	defer fake.bulkEnableChainhooksMutex.Unlock()
+	fake.BulkEnableChainhooksStub = nil
+	fake.bulkEnableChainhooksReturns = struct {
+		result1 *chainhooks.BulkEnableChainhooksResponse
+		result2 error
+	}{result1, result2}
+}
+
+// BulkEnableChainhooksReturnsOnCall programs the results returned by the i-th call to BulkEnableChainhooks.
+func (fake *FakeChainhooksAPI) BulkEnableChainhooksReturnsOnCall(i int, result1 *chainhooks.BulkEnableChainhooksResponse, result2 error) {
+	fake.bulkEnableChainhooksMutex.Lock()
+	defer fake.bulkEnableChainhooksMutex.Unlock()
+	fake.BulkEnableChainhooksStub = nil
+	if fake.bulkEnableChainhooksReturnsOnCall == nil {
+		fake.bulkEnableChainhooksReturnsOnCall = make(map[int]struct {
+			result1 *chainhooks.BulkEnableChainhooksResponse
+			result2 error
+		})
+	}
+	fake.bulkEnableChainhooksReturnsOnCall[i] = struct {
+		result1 *chainhooks.BulkEnableChainhooksResponse
+		result2 error
+	}{result1, result2}
+}
+
+// DeleteChainhook implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) DeleteChainhook(arg1 context.Context, arg2 chainhooks.UUID) error {
+	fake.deleteChainhookMutex.Lock()
+	ret, specificReturn := fake.deleteChainhookReturnsOnCall[len(fake.deleteChainhookArgsForCall)]
+	fake.deleteChainhookArgsForCall = append(fake.deleteChainhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 chainhooks.UUID
+	}{arg1, arg2})
+	stub := fake.DeleteChainhookStub
+	fakeReturns := fake.deleteChainhookReturns
+	fake.recordInvocation("DeleteChainhook", []interface{}{arg1, arg2})
+	fake.deleteChainhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+// DeleteChainhookCallCount returns the number of times DeleteChainhook has been called.
+func (fake *FakeChainhooksAPI) DeleteChainhookCallCount() int {
+	fake.deleteChainhookMutex.RLock()
+	defer fake.deleteChainhookMutex.RUnlock()
+	return len(fake.deleteChainhookArgsForCall)
+}
+
+// DeleteChainhookCalls sets a stub that computes the results of DeleteChainhook.
+func (fake *FakeChainhooksAPI) DeleteChainhookCalls(stub func(context.Context, chainhooks.UUID) error) {
+	fake.deleteChainhookMutex.Lock()
+	defer fake.deleteChainhookMutex.Unlock()
+	fake.DeleteChainhookStub = stub
+}
+
+// DeleteChainhookArgsForCall returns the arguments of the i-th call to DeleteChainhook.
+func (fake *FakeChainhooksAPI) DeleteChainhookArgsForCall(i int) (context.Context, chainhooks.UUID) {
+	fake.deleteChainhookMutex.RLock()
+	defer fake.deleteChainhookMutex.RUnlock()
+	argsForCall := fake.deleteChainhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+// DeleteChainhookReturns programs the results returned by every call to DeleteChainhook.
+func (fake *FakeChainhooksAPI) DeleteChainhookReturns(result1 error) {
+	fake.deleteChainhookMutex.Lock()
+	defer fake.deleteChainhookMutex.Unlock()
+	fake.DeleteChainhookStub = nil
+	fake.deleteChainhookReturns = struct {
+		result1 error
+	}{result1}
+}
+
+// DeleteChainhookReturnsOnCall programs the results returned by the i-th call to DeleteChainhook.
+func (fake *FakeChainhooksAPI) DeleteChainhookReturnsOnCall(i int, result1 error) {
+	fake.deleteChainhookMutex.Lock()
+	defer fake.deleteChainhookMutex.Unlock()
+	fake.DeleteChainhookStub = nil
+	if fake.deleteChainhookReturnsOnCall == nil {
+		fake.deleteChainhookReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteChainhookReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+// RotateConsumerSecret implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) RotateConsumerSecret(arg1 context.Context) (*chainhooks.ConsumerSecretResponse, error) {
+	fake.rotateConsumerSecretMutex.Lock()
+	ret, specificReturn := fake.rotateConsumerSecretReturnsOnCall[len(fake.rotateConsumerSecretArgsForCall)]
+	fake.rotateConsumerSecretArgsForCall = append(fake.rotateConsumerSecretArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.RotateConsumerSecretStub
+	fakeReturns := fake.rotateConsumerSecretReturns
+	fake.recordInvocation("RotateConsumerSecret", []interface{}{arg1})
+	fake.rotateConsumerSecretMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+// RotateConsumerSecretCallCount returns the number of times RotateConsumerSecret has been called.
+func (fake *FakeChainhooksAPI) RotateConsumerSecretCallCount() int {
+	fake.rotateConsumerSecretMutex.RLock()
+	defer fake.rotateConsumerSecretMutex.RUnlock()
+	return len(fake.rotateConsumerSecretArgsForCall)
+}
+
+// RotateConsumerSecretCalls sets a stub that computes the results of RotateConsumerSecret.
+func (fake *FakeChainhooksAPI) RotateConsumerSecretCalls(stub func(context.Context) (*chainhooks.ConsumerSecretResponse, error)) {
+	fake.rotateConsumerSecretMutex.Lock()
+	defer fake.rotateConsumerSecretMutex.Unlock()
+	fake.RotateConsumerSecretStub = stub
+}
+
+// RotateConsumerSecretArgsForCall returns the arguments of the i-th call to RotateConsumerSecret.
+func (fake *FakeChainhooksAPI) RotateConsumerSecretArgsForCall(i int) context.Context {
+	fake.rotateConsumerSecretMutex.RLock()
+	defer fake.rotateConsumerSecretMutex.RUnlock()
+	argsForCall := fake.rotateConsumerSecretArgsForCall[i]
+	return argsForCall.arg1
+}
+
+// RotateConsumerSecretReturns programs the results returned by every call to RotateConsumerSecret.
+func (fake *FakeChainhooksAPI) RotateConsumerSecretReturns(result1 *chainhooks.ConsumerSecretResponse, result2 error) {
+	fake.rotateConsumerSecretMutex.Lock()
+	defer fake.rotateConsumerSecretMutex.Unlock()
+	fake.RotateConsumerSecretStub = nil
+	fake.rotateConsumerSecretReturns = struct {
+		result1 *chainhooks.ConsumerSecretResponse
+		result2 error
+	}{result1, result2}
+}
+
+// RotateConsumerSecretReturnsOnCall programs the results returned by the i-th call to RotateConsumerSecret.
+func (fake *FakeChainhooksAPI) RotateConsumerSecretReturnsOnCall(i int, result1 *chainhooks.ConsumerSecretResponse, result2 error) {
+	fake.rotateConsumerSecretMutex.Lock()
+	defer fake.rotateConsumerSecretMutex.Unlock()
+	fake.RotateConsumerSecretStub = nil
+	if fake.rotateConsumerSecretReturnsOnCall == nil {
+		fake.rotateConsumerSecretReturnsOnCall = make(map[int]struct {
+			result1 *chainhooks.ConsumerSecretResponse
+			result2 error
+		})
+	}
+	fake.rotateConsumerSecretReturnsOnCall[i] = struct {
+		result1 *chainhooks.ConsumerSecretResponse
+		result2 error
+	}{result1, result2}
+}
+
+// GetConsumerSecret implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) GetConsumerSecret(arg1 context.Context) (*chainhooks.ConsumerSecretResponse, error) {
+	fake.getConsumerSecretMutex.Lock()
+	ret, specificReturn := fake.getConsumerSecretReturnsOnCall[len(fake.getConsumerSecretArgsForCall)]
+	fake.getConsumerSecretArgsForCall = append(fake.getConsumerSecretArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetConsumerSecretStub
+	fakeReturns := fake.getConsumerSecretReturns
+	fake.recordInvocation("GetConsumerSecret", []interface{}{arg1})
+	fake.getConsumerSecretMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+// GetConsumerSecretCallCount returns the number of times GetConsumerSecret has been called.
+func (fake *FakeChainhooksAPI) GetConsumerSecretCallCount() int {
+	fake.getConsumerSecretMutex.RLock()
+	defer fake.getConsumerSecretMutex.RUnlock()
+	return len(fake.getConsumerSecretArgsForCall)
+}
+
+// GetConsumerSecretCalls sets a stub that computes the results of GetConsumerSecret.
+func (fake *FakeChainhooksAPI) GetConsumerSecretCalls(stub func(context.Context) (*chainhooks.ConsumerSecretResponse, error)) {
+	fake.getConsumerSecretMutex.Lock()
+	defer fake.getConsumerSecretMutex.Unlock()
+	fake.GetConsumerSecretStub = stub
+}
+
+// GetConsumerSecretArgsForCall returns the arguments of the i-th call to GetConsumerSecret.
+func (fake *FakeChainhooksAPI) GetConsumerSecretArgsForCall(i int) context.Context {
+	fake.getConsumerSecretMutex.RLock()
+	defer fake.getConsumerSecretMutex.RUnlock()
+	argsForCall := fake.getConsumerSecretArgsForCall[i]
+	return argsForCall.arg1
+}
+
+// GetConsumerSecretReturns programs the results returned by every call to GetConsumerSecret.
+func (fake *FakeChainhooksAPI) GetConsumerSecretReturns(result1 *chainhooks.ConsumerSecretResponse, result2 error) {
+	fake.getConsumerSecretMutex.Lock()
+	defer fake.getConsumerSecretMutex.Unlock()
+	fake.GetConsumerSecretStub = nil
+	fake.getConsumerSecretReturns = struct {
+		result1 *chainhooks.ConsumerSecretResponse
+		result2 error
+	}{result1, result2}
+}
+
+// GetConsumerSecretReturnsOnCall programs the results returned by the i-th call to GetConsumerSecret.
+func (fake *FakeChainhooksAPI) GetConsumerSecretReturnsOnCall(i int, result1 *chainhooks.ConsumerSecretResponse, result2 error) {
+	fake.getConsumerSecretMutex.Lock()
+	defer fake.getConsumerSecretMutex.Unlock()
+	fake.GetConsumerSecretStub = nil
+	if fake.getConsumerSecretReturnsOnCall == nil {
+		fake.getConsumerSecretReturnsOnCall = make(map[int]struct {
+			result1 *chainhooks.ConsumerSecretResponse
+			result2 error
+		})
+	}
+	fake.getConsumerSecretReturnsOnCall[i] = struct {
+		result1 *chainhooks.ConsumerSecretResponse
+		result2 error
+	}{result1, result2}
+}
+
+// DeleteConsumerSecret implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) DeleteConsumerSecret(arg1 context.Context) error {
+	fake.deleteConsumerSecretMutex.Lock()
+	ret, specificReturn := fake.deleteConsumerSecretReturnsOnCall[len(fake.deleteConsumerSecretArgsForCall)]
+	fake.deleteConsumerSecretArgsForCall = append(fake.deleteConsumerSecretArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.DeleteConsumerSecretStub
+	fakeReturns := fake.deleteConsumerSecretReturns
+	fake.recordInvocation("DeleteConsumerSecret", []interface{}{arg1})
+	fake.deleteConsumerSecretMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+// DeleteConsumerSecretCallCount returns the number of times DeleteConsumerSecret has been called.
+func (fake *FakeChainhooksAPI) DeleteConsumerSecretCallCount() int {
+	fake.deleteConsumerSecretMutex.RLock()
+	defer fake.deleteConsumerSecretMutex.RUnlock()
+	return len(fake.deleteConsumerSecretArgsForCall)
+}
+
+// DeleteConsumerSecretCalls sets a stub that computes the results of DeleteConsumerSecret.
+func (fake *FakeChainhooksAPI) DeleteConsumerSecretCalls(stub func(context.Context) error) {
+	fake.deleteConsumerSecretMutex.Lock()
+	defer fake.deleteConsumerSecretMutex.Unlock()
+	fake.DeleteConsumerSecretStub = stub
+}
+
+// DeleteConsumerSecretArgsForCall returns the arguments of the i-th call to DeleteConsumerSecret.
+func (fake *FakeChainhooksAPI) DeleteConsumerSecretArgsForCall(i int) context.Context {
+	fake.deleteConsumerSecretMutex.RLock()
+	defer fake.deleteConsumerSecretMutex.RUnlock()
+	argsForCall := fake.deleteConsumerSecretArgsForCall[i]
+	return argsForCall.arg1
+}
+
+// DeleteConsumerSecretReturns programs the results returned by every call to DeleteConsumerSecret.
+func (fake *FakeChainhooksAPI) DeleteConsumerSecretReturns(result1 error) {
+	fake.deleteConsumerSecretMutex.Lock()
+	defer fake.deleteConsumerSecretMutex.Unlock()
+	fake.DeleteConsumerSecretStub = nil
+	fake.deleteConsumerSecretReturns = struct {
+		result1 error
+	}{result1}
+}
+
+// DeleteConsumerSecretReturnsOnCall programs the results returned by the i-th call to DeleteConsumerSecret.
+func (fake *FakeChainhooksAPI) DeleteConsumerSecretReturnsOnCall(i int, result1 error) {
+	fake.deleteConsumerSecretMutex.Lock()
+	defer fake.deleteConsumerSecretMutex.Unlock()
+	fake.DeleteConsumerSecretStub = nil
+	if fake.deleteConsumerSecretReturnsOnCall == nil {
+		fake.deleteConsumerSecretReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteConsumerSecretReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+// EvaluateChainhook implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) EvaluateChainhook(arg1 context.Context, arg2 chainhooks.UUID, arg3 uint64) error {
+	fake.evaluateChainhookMutex.Lock()
+	ret, specificReturn := fake.evaluateChainhookReturnsOnCall[len(fake.evaluateChainhookArgsForCall)]
+	fake.evaluateChainhookArgsForCall = append(fake.evaluateChainhookArgsForCall, struct {
+		arg1 context.Context
+		arg2 chainhooks.UUID
+		arg3 uint64
+	}{arg1, arg2, arg3})
+	stub := fake.EvaluateChainhookStub
+	fakeReturns := fake.evaluateChainhookReturns
+	fake.recordInvocation("EvaluateChainhook", []interface{}{arg1, arg2, arg3})
+	fake.evaluateChainhookMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+// EvaluateChainhookCallCount returns the number of times EvaluateChainhook has been called.
+func (fake *FakeChainhooksAPI) EvaluateChainhookCallCount() int {
+	fake.evaluateChainhookMutex.RLock()
+	defer fake.evaluateChainhookMutex.RUnlock()
+	return len(fake.evaluateChainhookArgsForCall)
+}
+
+// EvaluateChainhookCalls sets a stub that computes the results of EvaluateChainhook.
+func (fake *FakeChainhooksAPI) EvaluateChainhookCalls(stub func(context.Context, chainhooks.UUID, uint64) error) {
+	fake.evaluateChainhookMutex.Lock()
+	defer fake.evaluateChainhookMutex.Unlock()
+	fake.EvaluateChainhookStub = stub
+}
+
+// EvaluateChainhookArgsForCall returns the arguments of the i-th call to EvaluateChainhook.
+func (fake *FakeChainhooksAPI) EvaluateChainhookArgsForCall(i int) (context.Context, chainhooks.UUID, uint64) {
+	fake.evaluateChainhookMutex.RLock()
+	defer fake.evaluateChainhookMutex.RUnlock()
+	argsForCall := fake.evaluateChainhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+// EvaluateChainhookReturns programs the results returned by every call to EvaluateChainhook.
+func (fake *FakeChainhooksAPI) EvaluateChainhookReturns(result1 error) {
+	fake.evaluateChainhookMutex.Lock()
+	defer fake.evaluateChainhookMutex.Unlock()
+	fake.EvaluateChainhookStub = nil
+	fake.evaluateChainhookReturns = struct {
+		result1 error
+	}{result1}
+}
+
+// EvaluateChainhookReturnsOnCall programs the results returned by the i-th call to EvaluateChainhook.
+func (fake *FakeChainhooksAPI) EvaluateChainhookReturnsOnCall(i int, result1 error) {
+	fake.evaluateChainhookMutex.Lock()
+	defer fake.evaluateChainhookMutex.Unlock()
+	fake.EvaluateChainhookStub = nil
+	if fake.evaluateChainhookReturnsOnCall == nil {
+		fake.evaluateChainhookReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.evaluateChainhookReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+// GetStatus implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) GetStatus(arg1 context.Context) (*chainhooks.ApiStatusResponse, error) {
+	fake.getStatusMutex.Lock()
+	ret, specificReturn := fake.getStatusReturnsOnCall[len(fake.getStatusArgsForCall)]
+	fake.getStatusArgsForCall = append(fake.getStatusArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.GetStatusStub
+	fakeReturns := fake.getStatusReturns
+	fake.recordInvocation("GetStatus", []interface{}{arg1})
+	fake.getStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+// GetStatusCallCount returns the number of times GetStatus has been called.
+func (fake *FakeChainhooksAPI) GetStatusCallCount() int {
+	fake.getStatusMutex.RLock()
+	defer fake.getStatusMutex.RUnlock()
+	return len(fake.getStatusArgsForCall)
+}
+
+// GetStatusCalls sets a stub that computes the results of GetStatus.
+func (fake *FakeChainhooksAPI) GetStatusCalls(stub func(context.Context) (*chainhooks.ApiStatusResponse, error)) {
+	fake.getStatusMutex.Lock()
+	defer fake.getStatusMutex.Unlock()
+	fake.GetStatusStub = stub
+}
+
+// GetStatusArgsForCall returns the arguments of the i-th call to GetStatus.
+func (fake *FakeChainhooksAPI) GetStatusArgsForCall(i int) context.Context {
+	fake.getStatusMutex.RLock()
+	defer fake.getStatusMutex.RUnlock()
+	argsForCall := fake.getStatusArgsForCall[i]
+	return argsForCall.arg1
+}
+
+// GetStatusReturns programs the results returned by every call to GetStatus.
+func (fake *FakeChainhooksAPI) GetStatusReturns(result1 *chainhooks.ApiStatusResponse, result2 error) {
+	fake.getStatusMutex.Lock()
+	defer fake.getStatusMutex.Unlock()
+	fake.GetStatusStub = nil
+	fake.getStatusReturns = struct {
+		result1 *chainhooks.ApiStatusResponse
+		result2 error
+	}{result1, result2}
+}
+
+// GetStatusReturnsOnCall programs the results returned by the i-th call to GetStatus.
+func (fake *FakeChainhooksAPI) GetStatusReturnsOnCall(i int, result1 *chainhooks.ApiStatusResponse, result2 error) {
+	fake.getStatusMutex.Lock()
+	defer fake.getStatusMutex.Unlock()
+	fake.GetStatusStub = nil
+	if fake.getStatusReturnsOnCall == nil {
+		fake.getStatusReturnsOnCall = make(map[int]struct {
+			result1 *chainhooks.ApiStatusResponse
+			result2 error
+		})
+	}
+	fake.getStatusReturnsOnCall[i] = struct {
+		result1 *chainhooks.ApiStatusResponse
+		result2 error
+	}{result1, result2}
+}
+
+// AllChainhooks implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) AllChainhooks(arg1 context.Context, arg2 uint64) *chainhooks.ChainhookIterator {
+	fake.allChainhooksMutex.Lock()
+	ret, specificReturn := fake.allChainhooksReturnsOnCall[len(fake.allChainhooksArgsForCall)]
+	fake.allChainhooksArgsForCall = append(fake.allChainhooksArgsForCall, struct {
+		arg1 context.Context
+		arg2 uint64
+	}{arg1, arg2})
+	stub := fake.AllChainhooksStub
+	fakeReturns := fake.allChainhooksReturns
+	fake.recordInvocation("AllChainhooks", []interface{}{arg1, arg2})
+	fake.allChainhooksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	if fakeReturns.result1 == nil {
+		return chainhooks.NewChainhookIterator(arg1, fake.GetChainhooks, arg2)
+	}
+	return fakeReturns.result1
+}
+
+// AllChainhooksCallCount returns the number of times AllChainhooks has been called.
+func (fake *FakeChainhooksAPI) AllChainhooksCallCount() int {
+	fake.allChainhooksMutex.RLock()
+	defer fake.allChainhooksMutex.RUnlock()
+	return len(fake.allChainhooksArgsForCall)
+}
+
+// AllChainhooksCalls sets a stub that computes the results of AllChainhooks.
+func (fake *FakeChainhooksAPI) AllChainhooksCalls(stub func(context.Context, uint64) *chainhooks.ChainhookIterator) {
+	fake.allChainhooksMutex.Lock()
+	defer fake.allChainhooksMutex.Unlock()
+	fake.AllChainhooksStub = stub
+}
+
+// AllChainhooksArgsForCall returns the arguments of the i-th call to AllChainhooks.
+func (fake *FakeChainhooksAPI) AllChainhooksArgsForCall(i int) (context.Context, uint64) {
+	fake.allChainhooksMutex.RLock()
+	defer fake.allChainhooksMutex.RUnlock()
+	argsForCall := fake.allChainhooksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+// AllChainhooksReturns programs the results returned by every call to AllChainhooks.
+func (fake *FakeChainhooksAPI) AllChainhooksReturns(result1 *chainhooks.ChainhookIterator) {
+	fake.allChainhooksMutex.Lock()
+	defer fake.allChainhooksMutex.Unlock()
+	fake.AllChainhooksStub = nil
+	fake.allChainhooksReturns = struct {
+		result1 *chainhooks.ChainhookIterator
+	}{result1}
+}
+
+// AllChainhooksReturnsOnCall programs the results returned by the i-th call to AllChainhooks.
+func (fake *FakeChainhooksAPI) AllChainhooksReturnsOnCall(i int, result1 *chainhooks.ChainhookIterator) {
+	fake.allChainhooksMutex.Lock()
+	defer fake.allChainhooksMutex.Unlock()
+	fake.AllChainhooksStub = nil
+	if fake.allChainhooksReturnsOnCall == nil {
+		fake.allChainhooksReturnsOnCall = make(map[int]struct {
+			result1 *chainhooks.ChainhookIterator
+		})
+	}
+	fake.allChainhooksReturnsOnCall[i] = struct {
+		result1 *chainhooks.ChainhookIterator
+	}{result1}
+}
+
+// FindChainhooks implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) FindChainhooks(arg1 context.Context, arg2 *chainhooks.ChainhookQuery) ([]chainhooks.Chainhook, error) {
+	fake.findChainhooksMutex.Lock()
+	ret, specificReturn := fake.findChainhooksReturnsOnCall[len(fake.findChainhooksArgsForCall)]
+	fake.findChainhooksArgsForCall = append(fake.findChainhooksArgsForCall, struct {
+		arg1 context.Context
+		arg2 *chainhooks.ChainhookQuery
+	}{arg1, arg2})
+	stub := fake.FindChainhooksStub
+	fakeReturns := fake.findChainhooksReturns
+	fake.recordInvocation("FindChainhooks", []interface{}{arg1, arg2})
+	fake.findChainhooksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+// FindChainhooksCallCount returns the number of times FindChainhooks has been called.
+func (fake *FakeChainhooksAPI) FindChainhooksCallCount() int {
+	fake.findChainhooksMutex.RLock()
+	defer fake.findChainhooksMutex.RUnlock()
+	return len(fake.findChainhooksArgsForCall)
+}
+
+// FindChainhooksCalls sets a stub that computes the results of FindChainhooks.
+func (fake *FakeChainhooksAPI) FindChainhooksCalls(stub func(context.Context, *chainhooks.ChainhookQuery) ([]chainhooks.Chainhook, error)) {
+	fake.findChainhooksMutex.Lock()
+	defer fake.findChainhooksMutex.Unlock()
+	fake.FindChainhooksStub = stub
+}
+
+// FindChainhooksArgsForCall returns the arguments of the i-th call to FindChainhooks.
+func (fake *FakeChainhooksAPI) FindChainhooksArgsForCall(i int) (context.Context, *chainhooks.ChainhookQuery) {
+	fake.findChainhooksMutex.RLock()
+	defer fake.findChainhooksMutex.RUnlock()
+	argsForCall := fake.findChainhooksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+// FindChainhooksReturns programs the results returned by every call to FindChainhooks.
+func (fake *FakeChainhooksAPI) FindChainhooksReturns(result1 []chainhooks.Chainhook, result2 error) {
+	fake.findChainhooksMutex.Lock()
+	defer fake.findChainhooksMutex.Unlock()
+	fake.FindChainhooksStub = nil
+	fake.findChainhooksReturns = struct {
+		result1 []chainhooks.Chainhook
+		result2 error
+	}{result1, result2}
+}
+
+// FindChainhooksReturnsOnCall programs the results returned by the i-th call to FindChainhooks.
+func (fake *FakeChainhooksAPI) FindChainhooksReturnsOnCall(i int, result1 []chainhooks.Chainhook, result2 error) {
+	fake.findChainhooksMutex.Lock()
+	defer fake.findChainhooksMutex.Unlock()
+	fake.FindChainhooksStub = nil
+	if fake.findChainhooksReturnsOnCall == nil {
+		fake.findChainhooksReturnsOnCall = make(map[int]struct {
+			result1 []chainhooks.Chainhook
+			result2 error
+		})
+	}
+	fake.findChainhooksReturnsOnCall[i] = struct {
+		result1 []chainhooks.Chainhook
+		result2 error
+	}{result1, result2}
+}
+
+// FindByName implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) FindByName(arg1 context.Context, arg2 string) ([]chainhooks.Chainhook, error) {
+	fake.findByNameMutex.Lock()
+	ret, specificReturn := fake.findByNameReturnsOnCall[len(fake.findByNameArgsForCall)]
+	fake.findByNameArgsForCall = append(fake.findByNameArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.FindByNameStub
+	fakeReturns := fake.findByNameReturns
+	fake.recordInvocation("FindByName", []interface{}{arg1, arg2})
+	fake.findByNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+// FindByNameCallCount returns the number of times FindByName has been called.
+func (fake *FakeChainhooksAPI) FindByNameCallCount() int {
+	fake.findByNameMutex.RLock()
+	defer fake.findByNameMutex.RUnlock()
+	return len(fake.findByNameArgsForCall)
+}
+
+// FindByNameCalls sets a stub that computes the results of FindByName.
+func (fake *FakeChainhooksAPI) FindByNameCalls(stub func(context.Context, string) ([]chainhooks.Chainhook, error)) {
+	fake.findByNameMutex.Lock()
+	defer fake.findByNameMutex.Unlock()
+	fake.FindByNameStub = stub
+}
+
+// FindByNameArgsForCall returns the arguments of the i-th call to FindByName.
+func (fake *FakeChainhooksAPI) FindByNameArgsForCall(i int) (context.Context, string) {
+	fake.findByNameMutex.RLock()
+	defer fake.findByNameMutex.RUnlock()
+	argsForCall := fake.findByNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+// FindByNameReturns programs the results returned by every call to FindByName.
+func (fake *FakeChainhooksAPI) FindByNameReturns(result1 []chainhooks.Chainhook, result2 error) {
+	fake.findByNameMutex.Lock()
+	defer fake.findByNameMutex.Unlock()
+	fake.FindByNameStub = nil
+	fake.findByNameReturns = struct {
+		result1 []chainhooks.Chainhook
+		result2 error
+	}{result1, result2}
+}
+
+// FindByNameReturnsOnCall programs the results returned by the i-th call to FindByName.
+func (fake *FakeChainhooksAPI) FindByNameReturnsOnCall(i int, result1 []chainhooks.Chainhook, result2 error) {
+	fake.findByNameMutex.Lock()
+	defer fake.findByNameMutex.Unlock()
+	fake.FindByNameStub = nil
+	if fake.findByNameReturnsOnCall == nil {
+		fake.findByNameReturnsOnCall = make(map[int]struct {
+			result1 []chainhooks.Chainhook
+			result2 error
+		})
+	}
+	fake.findByNameReturnsOnCall[i] = struct {
+		result1 []chainhooks.Chainhook
+		result2 error
+	}{result1, result2}
+}
+
+// FindByWebhookURL implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) FindByWebhookURL(arg1 context.Context, arg2 string) ([]chainhooks.Chainhook, error) {
+	fake.findByWebhookURLMutex.Lock()
+	ret, specificReturn := fake.findByWebhookURLReturnsOnCall[len(fake.findByWebhookURLArgsForCall)]
+	fake.findByWebhookURLArgsForCall = append(fake.findByWebhookURLArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.FindByWebhookURLStub
+	fakeReturns := fake.findByWebhookURLReturns
+	fake.recordInvocation("FindByWebhookURL", []interface{}{arg1, arg2})
+	fake.findByWebhookURLMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+// FindByWebhookURLCallCount returns the number of times FindByWebhookURL has been called.
+func (fake *FakeChainhooksAPI) FindByWebhookURLCallCount() int {
+	fake.findByWebhookURLMutex.RLock()
+	defer fake.findByWebhookURLMutex.RUnlock()
+	return len(fake.findByWebhookURLArgsForCall)
+}
+
+// FindByWebhookURLCalls sets a stub that computes the results of FindByWebhookURL.
+func (fake *FakeChainhooksAPI) FindByWebhookURLCalls(stub func(context.Context, string) ([]chainhooks.Chainhook, error)) {
+	fake.findByWebhookURLMutex.Lock()
+	defer fake.findByWebhookURLMutex.Unlock()
+	fake.FindByWebhookURLStub = stub
+}
+
+// FindByWebhookURLArgsForCall returns the arguments of the i-th call to FindByWebhookURL.
+func (fake *FakeChainhooksAPI) FindByWebhookURLArgsForCall(i int) (context.Context, string) {
+	fake.findByWebhookURLMutex.RLock()
+	defer fake.findByWebhookURLMutex.RUnlock()
+	argsForCall := fake.findByWebhookURLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+// FindByWebhookURLReturns programs the results returned by every call to FindByWebhookURL.
+func (fake *FakeChainhooksAPI) FindByWebhookURLReturns(result1 []chainhooks.Chainhook, result2 error) {
+	fake.findByWebhookURLMutex.Lock()
+	defer fake.findByWebhookURLMutex.Unlock()
+	fake.FindByWebhookURLStub = nil
+	fake.findByWebhookURLReturns = struct {
+		result1 []chainhooks.Chainhook
+		result2 error
+	}{result1, result2}
+}
+
+// FindByWebhookURLReturnsOnCall programs the results returned by the i-th call to FindByWebhookURL.
+func (fake *FakeChainhooksAPI) FindByWebhookURLReturnsOnCall(i int, result1 []chainhooks.Chainhook, result2 error) {
+	fake.findByWebhookURLMutex.Lock()
+	defer fake.findByWebhookURLMutex.Unlock()
+	fake.FindByWebhookURLStub = nil
+	if fake.findByWebhookURLReturnsOnCall == nil {
+		fake.findByWebhookURLReturnsOnCall = make(map[int]struct {
+			result1 []chainhooks.Chainhook
+			result2 error
+		})
+	}
+	fake.findByWebhookURLReturnsOnCall[i] = struct {
+		result1 []chainhooks.Chainhook
+		result2 error
+	}{result1, result2}
+}
+
+// ListChainhooksParallel implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) ListChainhooksParallel(arg1 context.Context, arg2 uint64) ([]chainhooks.Chainhook, error) {
+	fake.listChainhooksParallelMutex.Lock()
+	ret, specificReturn := fake.listChainhooksParallelReturnsOnCall[len(fake.listChainhooksParallelArgsForCall)]
+	fake.listChainhooksParallelArgsForCall = append(fake.listChainhooksParallelArgsForCall, struct {
+		arg1 context.Context
+		arg2 uint64
+	}{arg1, arg2})
+	stub := fake.ListChainhooksParallelStub
+	fakeReturns := fake.listChainhooksParallelReturns
+	fake.recordInvocation("ListChainhooksParallel", []interface{}{arg1, arg2})
+	fake.listChainhooksParallelMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+// ListChainhooksParallelCallCount returns the number of times ListChainhooksParallel has been called.
+func (fake *FakeChainhooksAPI) ListChainhooksParallelCallCount() int {
+	fake.listChainhooksParallelMutex.RLock()
+	defer fake.listChainhooksParallelMutex.RUnlock()
+	return len(fake.listChainhooksParallelArgsForCall)
+}
+
+// ListChainhooksParallelCalls sets a stub that computes the results of ListChainhooksParallel.
+func (fake *FakeChainhooksAPI) ListChainhooksParallelCalls(stub func(context.Context, uint64) ([]chainhooks.Chainhook, error)) {
+	fake.listChainhooksParallelMutex.Lock()
+	defer fake.listChainhooksParallelMutex.Unlock()
+	fake.ListChainhooksParallelStub = stub
+}
+
+// ListChainhooksParallelArgsForCall returns the arguments of the i-th call to ListChainhooksParallel.
+func (fake *FakeChainhooksAPI) ListChainhooksParallelArgsForCall(i int) (context.Context, uint64) {
+	fake.listChainhooksParallelMutex.RLock()
+	defer fake.listChainhooksParallelMutex.RUnlock()
+	argsForCall := fake.listChainhooksParallelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+// ListChainhooksParallelReturns programs the results returned by every call to ListChainhooksParallel.
+func (fake *FakeChainhooksAPI) ListChainhooksParallelReturns(result1 []chainhooks.Chainhook, result2 error) {
+	fake.listChainhooksParallelMutex.Lock()
+	defer fake.listChainhooksParallelMutex.Unlock()
+	fake.ListChainhooksParallelStub = nil
+	fake.listChainhooksParallelReturns = struct {
+		result1 []chainhooks.Chainhook
+		result2 error
+	}{result1, result2}
+}
+
+// ListChainhooksParallelReturnsOnCall programs the results returned by the i-th call to ListChainhooksParallel.
+func (fake *FakeChainhooksAPI) ListChainhooksParallelReturnsOnCall(i int, result1 []chainhooks.Chainhook, result2 error) {
+	fake.listChainhooksParallelMutex.Lock()
+	defer fake.listChainhooksParallelMutex.Unlock()
+	fake.ListChainhooksParallelStub = nil
+	if fake.listChainhooksParallelReturnsOnCall == nil {
+		fake.listChainhooksParallelReturnsOnCall = make(map[int]struct {
+			result1 []chainhooks.Chainhook
+			result2 error
+		})
+	}
+	fake.listChainhooksParallelReturnsOnCall[i] = struct {
+		result1 []chainhooks.Chainhook
+		result2 error
+	}{result1, result2}
+}
+
+// GetChainhooksByUUID implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) GetChainhooksByUUID(arg1 context.Context, arg2 []chainhooks.UUID) ([]chainhooks.ChainhookResult, error) {
+	var arg2Copy []chainhooks.UUID
+	if arg2 != nil {
+		arg2Copy = make([]chainhooks.UUID, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.getChainhooksByUUIDMutex.Lock()
+	ret, specificReturn := fake.getChainhooksByUUIDReturnsOnCall[len(fake.getChainhooksByUUIDArgsForCall)]
+	fake.getChainhooksByUUIDArgsForCall = append(fake.getChainhooksByUUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 []chainhooks.UUID
+	}{arg1, arg2Copy})
+	stub := fake.GetChainhooksByUUIDStub
+	fakeReturns := fake.getChainhooksByUUIDReturns
+	fake.recordInvocation("GetChainhooksByUUID", []interface{}{arg1, arg2Copy})
+	fake.getChainhooksByUUIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+// GetChainhooksByUUIDCallCount returns the number of times GetChainhooksByUUID has been called.
+func (fake *FakeChainhooksAPI) GetChainhooksByUUIDCallCount() int {
+	fake.getChainhooksByUUIDMutex.RLock()
+	defer fake.getChainhooksByUUIDMutex.RUnlock()
+	return len(fake.getChainhooksByUUIDArgsForCall)
+}
+
+// GetChainhooksByUUIDCalls sets a stub that computes the results of GetChainhooksByUUID.
+func (fake *FakeChainhooksAPI) GetChainhooksByUUIDCalls(stub func(context.Context, []chainhooks.UUID) ([]chainhooks.ChainhookResult, error)) {
+	fake.getChainhooksByUUIDMutex.Lock()
+	defer fake.getChainhooksByUUIDMutex.Unlock()
+	fake.GetChainhooksByUUIDStub = stub
+}
+
+// GetChainhooksByUUIDArgsForCall returns the arguments of the i-th call to GetChainhooksByUUID.
+func (fake *FakeChainhooksAPI) GetChainhooksByUUIDArgsForCall(i int) (context.Context, []chainhooks.UUID) {
+	fake.getChainhooksByUUIDMutex.RLock()
+	defer fake.getChainhooksByUUIDMutex.RUnlock()
+	argsForCall := fake.getChainhooksByUUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+// GetChainhooksByUUIDReturns programs the results returned by every call to GetChainhooksByUUID.
+func (fake *FakeChainhooksAPI) GetChainhooksByUUIDReturns(result1 []chainhooks.ChainhookResult, result2 error) {
+	fake.getChainhooksByUUIDMutex.Lock()
+	defer fake.getChainhooksByUUIDMutex.Unlock()
+	fake.GetChainhooksByUUIDStub = nil
+	fake.getChainhooksByUUIDReturns = struct {
+		result1 []chainhooks.ChainhookResult
+		result2 error
+	}{result1, result2}
+}
+
+// GetChainhooksByUUIDReturnsOnCall programs the results returned by the i-th call to GetChainhooksByUUID.
+func (fake *FakeChainhooksAPI) GetChainhooksByUUIDReturnsOnCall(i int, result1 []chainhooks.ChainhookResult, result2 error) {
+	fake.getChainhooksByUUIDMutex.Lock()
+	defer fake.getChainhooksByUUIDMutex.Unlock()
+	fake.GetChainhooksByUUIDStub = nil
+	if fake.getChainhooksByUUIDReturnsOnCall == nil {
+		fake.getChainhooksByUUIDReturnsOnCall = make(map[int]struct {
+			result1 []chainhooks.ChainhookResult
+			result2 error
+		})
+	}
+	fake.getChainhooksByUUIDReturnsOnCall[i] = struct {
+		result1 []chainhooks.ChainhookResult
+		result2 error
+	}{result1, result2}
+}
+
+// PreviewBulkEnable implements chainhooks.ChainhooksAPI.
+func (fake *FakeChainhooksAPI) PreviewBulkEnable(arg1 context.Context, arg2 *chainhooks.BulkEnableChainhooksRequest) (*chainhooks.BulkEnablePreview, error) {
+	fake.previewBulkEnableMutex.Lock()
+	ret, specificReturn := fake.previewBulkEnableReturnsOnCall[len(fake.previewBulkEnableArgsForCall)]
+	fake.previewBulkEnableArgsForCall = append(fake.previewBulkEnableArgsForCall, struct {
+		arg1 context.Context
+		arg2 *chainhooks.BulkEnableChainhooksRequest
+	}{arg1, arg2})
+	stub := fake.PreviewBulkEnableStub
+	fakeReturns := fake.previewBulkEnableReturns
+	fake.recordInvocation("PreviewBulkEnable", []interface{}{arg1, arg2})
+	fake.previewBulkEnableMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+// PreviewBulkEnableCallCount returns the number of times PreviewBulkEnable has been called.
+func (fake *FakeChainhooksAPI) PreviewBulkEnableCallCount() int {
+	fake.previewBulkEnableMutex.RLock()
+	defer fake.previewBulkEnableMutex.RUnlock()
+	return len(fake.previewBulkEnableArgsForCall)
+}
+
+// PreviewBulkEnableCalls sets a stub that computes the results of PreviewBulkEnable.
+func (fake *FakeChainhooksAPI) PreviewBulkEnableCalls(stub func(context.Context, *chainhooks.BulkEnableChainhooksRequest) (*chainhooks.BulkEnablePreview, error)) {
+	fake.previewBulkEnableMutex.Lock()
+	defer fake.previewBulkEnableMutex.Unlock()
+	fake.PreviewBulkEnableStub = stub
+}
+
+// PreviewBulkEnableArgsForCall returns the arguments of the i-th call to PreviewBulkEnable.
+func (fake *FakeChainhooksAPI) PreviewBulkEnableArgsForCall(i int) (context.Context, *chainhooks.BulkEnableChainhooksRequest) {
+	fake.previewBulkEnableMutex.RLock()
+	defer fake.previewBulkEnableMutex.RUnlock()
+	argsForCall := fake.previewBulkEnableArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+// PreviewBulkEnableReturns programs the results returned by every call to PreviewBulkEnable.
+func (fake *FakeChainhooksAPI) PreviewBulkEnableReturns(result1 *chainhooks.BulkEnablePreview, result2 error) {
+	fake.previewBulkEnableMutex.Lock()
+	defer fake.previewBulkEnableMutex.Unlock()
+	fake.PreviewBulkEnableStub = nil
+	fake.previewBulkEnableReturns = struct {
+		result1 *chainhooks.BulkEnablePreview
+		result2 error
+	}{result1, result2}
+}
+
+// PreviewBulkEnableReturnsOnCall programs the results returned by the i-th call to PreviewBulkEnable.
+func (fake *FakeChainhooksAPI) PreviewBulkEnableReturnsOnCall(i int, result1 *chainhooks.BulkEnablePreview, result2 error) {
+	fake.previewBulkEnableMutex.Lock()
+	defer fake.previewBulkEnableMutex.Unlock()
+	fake.PreviewBulkEnableStub = nil
+	if fake.previewBulkEnableReturnsOnCall == nil {
+		fake.previewBulkEnableReturnsOnCall = make(map[int]struct {
+			result1 *chainhooks.BulkEnablePreview
+			result2 error
+		})
+	}
+	fake.previewBulkEnableReturnsOnCall[i] = struct {
+		result1 *chainhooks.BulkEnablePreview
+		result2 error
+	}{result1, result2}
+}
+
+// Invocations returns the arguments of every recorded call, keyed by method name.
+func (fake *FakeChainhooksAPI) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeChainhooksAPI) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+// Ensure FakeChainhooksAPI implements chainhooks.ChainhooksAPI.
+var _ chainhooks.ChainhooksAPI = new(FakeChainhooksAPI)
@@ -0,0 +1,50 @@
+package chainhooksfakes_test
+
+import (
+	"context"
+	"testing"
+
+	chainhooks "github.com/tony1908/chainhooks-client-go"
+	"github.com/tony1908/chainhooks-client-go/chainhooksfakes"
+)
+
+func TestAllChainhooksIteratesGetChainhooks(t *testing.T) {
+	fake := &chainhooksfakes.FakeChainhooksAPI{}
+	fake.GetChainhooksReturnsOnCall(0, &chainhooks.PaginatedChainhookResponse{
+		Total:      3,
+		Chainhooks: []chainhooks.Chainhook{{UUID: "uuid-1"}, {UUID: "uuid-2"}},
+	}, nil)
+	fake.GetChainhooksReturnsOnCall(1, &chainhooks.PaginatedChainhookResponse{
+		Total:      3,
+		Chainhooks: []chainhooks.Chainhook{{UUID: "uuid-3"}},
+	}, nil)
+
+	var got []chainhooks.UUID
+	it := fake.AllChainhooks(context.Background(), 2)
+	for it.Next() {
+		got = append(got, it.Chainhook().UUID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || got[0] != "uuid-1" || got[2] != "uuid-3" {
+		t.Fatalf("unexpected hooks %v", got)
+	}
+
+	if n := fake.GetChainhooksCallCount(); n != 2 {
+		t.Fatalf("expected 2 page requests, got %d", n)
+	}
+	_, opts := fake.GetChainhooksArgsForCall(1)
+	if opts.Offset != 2 || opts.Limit != 2 {
+		t.Fatalf("unexpected second page options %+v", opts)
+	}
+
+	// A programmed iterator takes precedence.
+	programmed := chainhooks.NewChainhookIterator(context.Background(), func(context.Context, *chainhooks.PaginationOptions) (*chainhooks.PaginatedChainhookResponse, error) {
+		return &chainhooks.PaginatedChainhookResponse{}, nil
+	}, 0)
+	fake.AllChainhooksReturns(programmed)
+	if fake.AllChainhooks(context.Background(), 2) != programmed {
+		t.Fatal("expected the programmed iterator")
+	}
+}
@@ -21,6 +21,13 @@ type Client struct {
 	userAgent   string
 	timeout     time.Duration
 	headers     map[string]string
+	cache       Cache
+	cacheTTL    time.Duration
+	flights     *flightGroup
+	generations cacheGenerations
+	configErr   error
+	rateLimiter RateLimiter
+	concurrency int
 }
 
 // ClientConfig represents the configuration for creating a new client.
@@ -31,6 +38,34 @@ type ClientConfig struct {
 	HTTPClient *http.Client
 	Timeout   time.Duration
 	UserAgent string
+
+	// Dialer, when set, is used by the HTTP transport to open connections.
+	Dialer Dialer
+
+	// Cache, when set, stores GetChainhook and GetChainhooks responses.
+	// Entries are served without a request for CacheTTL and are then
+	// revalidated with If-None-Match when the server returned an ETag.
+	Cache    Cache
+	CacheTTL time.Duration
+
+	// CoalesceRequests shares a single response between identical GET
+	// requests that are in flight at the same time.
+	CoalesceRequests bool
+
+	// RateLimiter, when set, is waited on before every request.
+	RateLimiter RateLimiter
+
+	// MaxConcurrency bounds the number of concurrent requests made by
+	// ListChainhooksParallel and GetChainhooksByUUID. Defaults to
+	// DefaultMaxConcurrency.
+	MaxConcurrency int
+}
+
+// RateLimiter limits the rate of requests made by a client.
+//
+// *rate.Limiter from golang.org/x/time/rate satisfies this interface.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
 }
 
 // NewClient creates a new Chainhooks API client.
@@ -45,6 +80,11 @@ func NewClient(baseURL string) *Client {
 }
 
 // NewClientWithConfig creates a new client with custom configuration.
+//
+// BaseURL may use the unix:// scheme to reach the API through a Unix domain
+// socket, optionally followed by a colon and an HTTP path prefix, e.g.
+// "unix:///run/sidecar.sock:/hiro". Configuration problems that cannot be
+// resolved here are reported as a ConfigError by every request.
 func NewClientWithConfig(cfg *ClientConfig) *Client {
 	if cfg == nil {
 		cfg = &ClientConfig{}
@@ -64,6 +104,10 @@ func NewClientWithConfig(cfg *ClientConfig) *Client {
 		cfg.HTTPClient.Timeout = cfg.Timeout
 	}
 
+	if cfg.MaxConcurrency <= 0 {
+		cfg.MaxConcurrency = DefaultMaxConcurrency
+	}
+
 	if cfg.UserAgent == "" {
 		cfg.UserAgent = "chainhooks-client-go/1.0.0"
 	}
@@ -71,14 +115,41 @@ func NewClientWithConfig(cfg *ClientConfig) *Client {
 	// Ensure baseURL doesn't have trailing slash
 	cfg.BaseURL = strings.TrimSuffix(cfg.BaseURL, "/")
 
+	// Route unix:// base URLs through the socket with a placeholder host
+	baseURL := cfg.BaseURL
+	var socketPath string
+	var configErr error
+	if strings.HasPrefix(baseURL, unixScheme) {
+		var prefix string
+		socketPath, prefix, configErr = parseUnixBaseURL(baseURL)
+		baseURL = "http://" + unixHost + prefix
+	}
+
+	httpClient, err := configureTransport(cfg.HTTPClient, cfg.Dialer, socketPath)
+	if err != nil {
+		httpClient = cfg.HTTPClient
+		if configErr == nil {
+			configErr = err
+		}
+	}
+
 	client := &Client{
-		baseURL:    cfg.BaseURL,
-		apiKey:     cfg.APIKey,
-		jwt:        cfg.JWT,
-		httpClient: cfg.HTTPClient,
-		userAgent:  cfg.UserAgent,
-		timeout:    cfg.Timeout,
-		headers:    make(map[string]string),
+		baseURL:     baseURL,
+		apiKey:      cfg.APIKey,
+		jwt:         cfg.JWT,
+		httpClient:  httpClient,
+		userAgent:   cfg.UserAgent,
+		timeout:     cfg.Timeout,
+		headers:     make(map[string]string),
+		cache:       cfg.Cache,
+		cacheTTL:    cfg.CacheTTL,
+		configErr:   configErr,
+		rateLimiter: cfg.RateLimiter,
+		concurrency: cfg.MaxConcurrency,
+	}
+
+	if cfg.CoalesceRequests {
+		client.flights = &flightGroup{timeout: httpClient.Timeout}
 	}
 
 	// Set default headers
@@ -103,8 +174,119 @@ func (c *Client) SetHeader(key, value string) {
 	c.headers[key] = value
 }
 
+// rawResponse is a fully read HTTP response from the Chainhooks API.
+type rawResponse struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
 // request performs an HTTP request to the Chainhooks API.
 func (c *Client) request(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	var resp *rawResponse
+	var err error
+	if method == MethodGET && c.flights != nil {
+		resp, err = c.flights.do(ctx, c.baseURL+path, func(ctx context.Context) (*rawResponse, error) {
+			return c.do(ctx, method, path, body, nil)
+		})
+	} else {
+		resp, err = c.do(ctx, method, path, body, nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	return decodeResponse(resp.body, result)
+}
+
+// cachedRequest performs a GET request for a chainhook read, serving it from
+// the client cache when a fresh entry exists and revalidating stale entries
+// with If-None-Match when the server provided an ETag.
+func (c *Client) cachedRequest(ctx context.Context, path string, result interface{}) error {
+	if c.cache == nil {
+		return c.request(ctx, MethodGET, path, nil, result)
+	}
+
+	key := c.baseURL + path
+	entry, ok := c.cache.Get(key)
+	if ok && entry.Fresh(time.Now()) {
+		return decodeResponse(entry.Body, result)
+	}
+
+	var header http.Header
+	if ok && entry.ETag != "" {
+		header = http.Header{}
+		header.Set(HeaderIfNoneMatch, entry.ETag)
+	}
+
+	fetch := func(ctx context.Context) (*rawResponse, error) {
+		return c.do(ctx, MethodGET, path, nil, header)
+	}
+
+	// Responses to reads that began before an invalidation are not stored
+	generation := c.generations.begin(key)
+	defer c.generations.end(key)
+
+	var resp *rawResponse
+	var err error
+	if c.flights != nil {
+		// Only callers revalidating the same ETag may share a response
+		resp, err = c.flights.do(ctx, key+"\x00"+header.Get(HeaderIfNoneMatch), fetch)
+	} else {
+		resp, err = fetch(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	if resp.statusCode == http.StatusNotModified && ok {
+		c.storeCacheEntry(key, generation, &CacheEntry{
+			Body:    entry.Body,
+			ETag:    entry.ETag,
+			Expires: time.Now().Add(c.cacheTTL),
+		})
+		return decodeResponse(entry.Body, result)
+	}
+
+	c.storeCacheEntry(key, generation, &CacheEntry{
+		Body:    resp.body,
+		ETag:    resp.header.Get(HeaderETag),
+		Expires: time.Now().Add(c.cacheTTL),
+	})
+
+	return decodeResponse(resp.body, result)
+}
+
+// uncachedRequest performs a GET that bypasses the cache and request
+// coalescing, for reads that must reflect the current server state.
+func (c *Client) uncachedRequest(ctx context.Context, path string, result interface{}) error {
+	resp, err := c.do(ctx, MethodGET, path, nil, nil)
+	if err != nil {
+		return err
+	}
+	return decodeResponse(resp.body, result)
+}
+
+// storeCacheEntry caches entry under key unless key was invalidated since
+// generation was read.
+func (c *Client) storeCacheEntry(key string, generation uint64, entry *CacheEntry) {
+	c.generations.store(key, generation, func() {
+		c.cache.Set(key, entry)
+	})
+}
+
+// do sends a single HTTP request and reads the full response.
+func (c *Client) do(ctx context.Context, method, path string, body interface{}, header http.Header) (*rawResponse, error) {
+	if c.configErr != nil {
+		return nil, c.configErr
+	}
+
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	fullURL := fmt.Sprintf("%s%s", c.baseURL, path)
 
 	// Encode request body
@@ -112,7 +294,7 @@ func (c *Client) request(ctx context.Context, method, path string, body interfac
 	if body != nil {
 		bodyBytes, err := json.Marshal(body)
 		if err != nil {
-			return fmt.Errorf("failed to marshal request body: %w", err)
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
 		bodyReader = bytes.NewReader(bodyBytes)
 	}
@@ -120,7 +302,7 @@ func (c *Client) request(ctx context.Context, method, path string, body interfac
 	// Create request
 	req, err := http.NewRequestWithContext(ctx, method, fullURL, bodyReader)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers (only set Content-Type if there's a body)
@@ -130,6 +312,11 @@ func (c *Client) request(ctx context.Context, method, path string, body interfac
 		}
 		req.Header.Set(key, value)
 	}
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
 
 	// Set authentication headers
 	if c.jwt != nil {
@@ -144,41 +331,77 @@ func (c *Client) request(ctx context.Context, method, path string, body interfac
 	// Perform request
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to perform request: %w", err)
+		return nil, fmt.Errorf("failed to perform request: %w", err)
 	}
 
 	// Handle response
 	if resp.StatusCode >= 400 {
-		return newHttpError(resp, req)
+		return nil, newHttpError(resp, req)
 	}
 
-	// For 204 No Content, don't try to unmarshal
-	if resp.StatusCode == http.StatusNoContent {
+	// For 204 No Content and 304 Not Modified there is no body to read
+	if resp.StatusCode == http.StatusNoContent || resp.StatusCode == http.StatusNotModified {
 		resp.Body.Close()
-		return nil
+		return &rawResponse{statusCode: resp.StatusCode, header: resp.Header}, nil
 	}
 
-	// Read and unmarshal response body
+	// Read response body
 	defer resp.Body.Close()
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	if result != nil && len(respBody) > 0 {
-		if err := json.Unmarshal(respBody, result); err != nil {
+	return &rawResponse{
+		statusCode: resp.StatusCode,
+		header:     resp.Header,
+		body:       respBody,
+	}, nil
+}
+
+// decodeResponse unmarshals a response body into result.
+func decodeResponse(body []byte, result interface{}) error {
+	if result != nil && len(body) > 0 {
+		if err := json.Unmarshal(body, result); err != nil {
 			return fmt.Errorf("failed to unmarshal response body: %w", err)
 		}
 	}
-
 	return nil
 }
 
+// invalidate removes cached reads affected by a mutation of the given
+// chainhooks. Listing pages are always removed since they embed every hook.
+func (c *Client) invalidate(uuids ...UUID) {
+	if c.cache == nil {
+		return
+	}
+	for _, uuid := range uuids {
+		key := c.baseURL + fmt.Sprintf(EndpointChainhook, uuid)
+		c.generations.bump(key, func() { c.cache.Delete(key) })
+	}
+	listings := c.baseURL + EndpointChainhooks + "?"
+	c.generations.bumpPrefix(listings, func() { c.cache.DeletePrefix(listings) })
+	listing := c.baseURL + EndpointChainhooks
+	c.generations.bump(listing, func() { c.cache.Delete(listing) })
+}
+
+// invalidateAll removes every cached chainhook read.
+func (c *Client) invalidateAll() {
+	if c.cache == nil {
+		return
+	}
+	prefix := c.baseURL + EndpointChainhooks
+	c.generations.bumpPrefix(prefix, func() { c.cache.DeletePrefix(prefix) })
+}
+
 // ============================================================================
 // Chainhook Management Methods
 // ============================================================================
 
 // RegisterChainhook registers a new chainhook.
+//
+// The definition is checked with ChainhookDefinition.Validate before it is
+// sent, so definitions holding a *RawEventFilter are rejected.
 func (c *Client) RegisterChainhook(ctx context.Context, definition *ChainhookDefinition) (*Chainhook, error) {
 	if definition == nil {
 		return nil, &ValidationError{
@@ -187,8 +410,13 @@ func (c *Client) RegisterChainhook(ctx context.Context, definition *ChainhookDef
 		}
 	}
 
+	if err := definition.Validate(); err != nil {
+		return nil, err
+	}
+
 	var result Chainhook
 	err := c.request(ctx, MethodPOST, EndpointChainhooks, definition, &result)
+	c.invalidate()
 	if err != nil {
 		return nil, err
 	}
@@ -197,6 +425,9 @@ func (c *Client) RegisterChainhook(ctx context.Context, definition *ChainhookDef
 }
 
 // UpdateChainhook updates an existing chainhook.
+//
+// The definition is checked with ChainhookDefinition.Validate before it is
+// sent, so definitions holding a *RawEventFilter are rejected.
 func (c *Client) UpdateChainhook(ctx context.Context, uuid UUID, definition *ChainhookDefinition) (*Chainhook, error) {
 	if uuid == "" {
 		return nil, &ValidationError{
@@ -212,9 +443,14 @@ func (c *Client) UpdateChainhook(ctx context.Context, uuid UUID, definition *Cha
 		}
 	}
 
+	if err := definition.Validate(); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf(EndpointChainhook, uuid)
 	var result Chainhook
 	err := c.request(ctx, MethodPATCH, path, definition, &result)
+	c.invalidate(uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -224,6 +460,29 @@ func (c *Client) UpdateChainhook(ctx context.Context, uuid UUID, definition *Cha
 
 // GetChainhooks retrieves all chainhooks with pagination support.
 func (c *Client) GetChainhooks(ctx context.Context, opts *PaginationOptions) (*PaginatedChainhookResponse, error) {
+	var result PaginatedChainhookResponse
+	err := c.cachedRequest(ctx, chainhooksPath(opts), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// getChainhooksUncached is like GetChainhooks but always reads from the
+// server.
+func (c *Client) getChainhooksUncached(ctx context.Context, opts *PaginationOptions) (*PaginatedChainhookResponse, error) {
+	var result PaginatedChainhookResponse
+	err := c.uncachedRequest(ctx, chainhooksPath(opts), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// chainhooksPath returns the path of one page of the chainhook listing.
+func chainhooksPath(opts *PaginationOptions) string {
 	path := EndpointChainhooks
 
 	// Add query parameters
@@ -233,14 +492,7 @@ func (c *Client) GetChainhooks(ctx context.Context, opts *PaginationOptions) (*P
 		params.Set("limit", fmt.Sprintf("%d", opts.Limit))
 		path = fmt.Sprintf("%s?%s", path, params.Encode())
 	}
-
-	var result PaginatedChainhookResponse
-	err := c.request(ctx, MethodGET, path, nil, &result)
-	if err != nil {
-		return nil, err
-	}
-
-	return &result, nil
+	return path
 }
 
 // GetChainhook retrieves a specific chainhook by UUID.
@@ -254,7 +506,7 @@ func (c *Client) GetChainhook(ctx context.Context, uuid UUID) (*Chainhook, error
 
 	path := fmt.Sprintf(EndpointChainhook, uuid)
 	var result Chainhook
-	err := c.request(ctx, MethodGET, path, nil, &result)
+	err := c.cachedRequest(ctx, path, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -274,32 +526,46 @@ func (c *Client) EnableChainhook(ctx context.Context, uuid UUID, enabled bool) e
 	path := fmt.Sprintf(EndpointChainhookEnabled, uuid)
 	body := map[string]bool{"enabled": enabled}
 
-	return c.request(ctx, MethodPATCH, path, body, nil)
+	err := c.request(ctx, MethodPATCH, path, body, nil)
+	c.invalidate(uuid)
+
+	return err
 }
 
 // BulkEnableChainhooks enables or disables multiple chainhooks based on filters.
+//
+// If request.ExpectedCount is set, the request is previewed with
+// PreviewBulkEnable first and is not sent unless exactly that many
+// chainhooks would change. The server's updated_count is then compared with
+// ExpectedCount, the number of chainhooks whose state changes; if it
+// differs, including when it also counts matched chainhooks that were
+// already in the requested state, the response is returned with a
+// *BulkEnableCountError.
 func (c *Client) BulkEnableChainhooks(ctx context.Context, request *BulkEnableChainhooksRequest) (*BulkEnableChainhooksResponse, error) {
-	if request == nil {
-		return nil, &ValidationError{
-			Field:  "request",
-			Reason: "request cannot be nil",
-		}
+	if err := validateBulkEnableRequest(request); err != nil {
+		return nil, err
 	}
 
-	// Validate that at least one filter is provided
-	if len(request.UUIDs) == 0 && request.WebhookURL == nil && len(request.Statuses) == 0 {
-		return nil, &ValidationError{
-			Field:  "request",
-			Reason: "at least one filter (uuids, webhook_url, or statuses) must be provided",
+	if request.ExpectedCount != nil {
+		preview, err := c.PreviewBulkEnable(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		if count := uint64(len(preview.WouldChange)); count != *request.ExpectedCount {
+			return nil, &BulkEnableCountError{Expected: *request.ExpectedCount, Actual: count}
 		}
 	}
 
 	var result BulkEnableChainhooksResponse
 	err := c.request(ctx, MethodPATCH, EndpointBulkEnabled, request, &result)
+	c.invalidateAll()
 	if err != nil {
 		return nil, err
 	}
 
+	if request.ExpectedCount != nil && result.UpdatedCount != *request.ExpectedCount {
+		return &result, &BulkEnableCountError{Expected: *request.ExpectedCount, Actual: result.UpdatedCount, Applied: true}
+	}
 	return &result, nil
 }
 
@@ -313,7 +579,10 @@ func (c *Client) DeleteChainhook(ctx context.Context, uuid UUID) error {
 	}
 
 	path := fmt.Sprintf(EndpointChainhook, uuid)
-	return c.request(ctx, MethodDELETE, path, nil, nil)
+	err := c.request(ctx, MethodDELETE, path, nil, nil)
+	c.invalidate(uuid)
+
+	return err
 }
 
 // ============================================================================
@@ -0,0 +1,329 @@
+package chainhooks
+
+import (
+	"fmt"
+	"go/format"
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ============================================================================
+// Go Code Generation
+// ============================================================================
+
+// chainhooksImportPath is the import path used by generated code.
+const chainhooksImportPath = "github.com/tony1908/chainhooks-client-go"
+
+// GoCodeOptions configures GenerateGoCode.
+type GoCodeOptions struct {
+	// Package is the package clause of the generated file. Defaults to
+	// "hooks".
+	Package string
+}
+
+// GenerateGoCode returns gofmt'd Go source that rebuilds each definition
+// with NewChainhookBuilder. Every definition gets a function named after it,
+// such as WhaleAlerts for "whale-alerts", and Definitions returns them all.
+// Calling a generated function returns a definition equal to the one it was
+// generated from.
+//
+// Definitions must be valid, and must use DefaultAPIVersion and
+// DefaultChain, since the builder sets them.
+func GenerateGoCode(defs []*ChainhookDefinition, opts *GoCodeOptions) ([]byte, error) {
+	hooks := make([]Chainhook, len(defs))
+	for i, def := range defs {
+		hooks[i] = Chainhook{Definition: def}
+	}
+	return GenerateGoCodeFromChainhooks(hooks, opts)
+}
+
+// GenerateGoCodeFromChainhooks is like GenerateGoCode for registered
+// chainhooks, such as the Chainhooks of a GetChainhooks response. The UUID
+// of each chainhook is noted in the comment of its function.
+func GenerateGoCodeFromChainhooks(hooks []Chainhook, opts *GoCodeOptions) ([]byte, error) {
+	pkg := "hooks"
+	if opts != nil && opts.Package != "" {
+		pkg = opts.Package
+	}
+	if !token.IsIdentifier(pkg) || pkg == "chainhooks" {
+		return nil, &ConfigError{Message: fmt.Sprintf("invalid package name %q", pkg)}
+	}
+
+	g := &goGenerator{used: map[string]bool{"Definitions": true}}
+	fmt.Fprintf(&g.buf, "package %s\n\nimport %q\n", pkg, chainhooksImportPath)
+
+	var names []string
+	for i := range hooks {
+		hook := &hooks[i]
+		name, err := g.definition(hook)
+		if err != nil {
+			if hook.UUID != "" {
+				return nil, fmt.Errorf("chainhook %s: %w", hook.UUID, err)
+			}
+			return nil, fmt.Errorf("definition %d: %w", i, err)
+		}
+		names = append(names, name)
+	}
+
+	g.buf.WriteString("\n// Definitions returns every generated definition.\n")
+	g.buf.WriteString("func Definitions() []*chainhooks.ChainhookDefinition {\n")
+	g.buf.WriteString("return []*chainhooks.ChainhookDefinition{\n")
+	for _, name := range names {
+		fmt.Fprintf(&g.buf, "%s(),\n", name)
+	}
+	g.buf.WriteString("}\n}\n")
+
+	return format.Source([]byte(g.buf.String()))
+}
+
+// goGenerator writes the generated source.
+type goGenerator struct {
+	buf  strings.Builder
+	used map[string]bool
+}
+
+// definition writes the function for one chainhook and returns its name.
+func (g *goGenerator) definition(hook *Chainhook) (string, error) {
+	def := hook.Definition
+	if def == nil {
+		return "", &ValidationError{Field: "definition", Reason: "definition cannot be nil"}
+	}
+	if err := def.Validate(); err != nil {
+		return "", err
+	}
+	if def.Version != DefaultAPIVersion {
+		return "", fmt.Errorf("version %q cannot be set with the builder", def.Version)
+	}
+	if def.Chain != DefaultChain {
+		return "", fmt.Errorf("chain %q cannot be set with the builder", def.Chain)
+	}
+
+	name := g.funcName(def.Name)
+	comment := fmt.Sprintf("// %s returns the %q chainhook definition.", name, def.Name)
+	if hook.UUID != "" {
+		comment = fmt.Sprintf("// %s returns the definition of chainhook %s (%q).", name, hook.UUID, def.Name)
+	}
+
+	fmt.Fprintf(&g.buf, "\n%s\nfunc %s() *chainhooks.ChainhookDefinition {\n", comment, name)
+	fmt.Fprintf(&g.buf, "return chainhooks.NewChainhookBuilder(%s, %s).\n", strconv.Quote(def.Name), goNetwork(def.Network))
+	fmt.Fprintf(&g.buf, "WithWebhookURL(%s).\n", strconv.Quote(def.Action.URL))
+	for _, filter := range def.Filters.Events {
+		call, err := goFilterCall(filter)
+		if err != nil {
+			return "", err
+		}
+		g.buf.WriteString(call + ".\n")
+	}
+	for _, call := range goOptionCalls(def.Options) {
+		g.buf.WriteString(call + ".\n")
+	}
+	g.buf.WriteString("MustBuild()\n}\n")
+	return name, nil
+}
+
+// funcName converts a chainhook name to an unused exported identifier, such
+// as WhaleAlerts for "whale-alerts".
+func (g *goGenerator) funcName(hookName string) string {
+	var sb strings.Builder
+	for _, word := range strings.FieldsFunc(hookName, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		runes := []rune(word)
+		sb.WriteRune(unicode.ToUpper(runes[0]))
+		sb.WriteString(string(runes[1:]))
+	}
+	base := sb.String()
+	if base == "" || !unicode.IsLetter([]rune(base)[0]) || !token.IsExported(base) {
+		base = "Chainhook" + base
+	}
+
+	name := base
+	for i := 2; g.used[name]; i++ {
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+	g.used[name] = true
+	return name
+}
+
+// goNetwork returns the constant for a network.
+func goNetwork(network Network) string {
+	switch network {
+	case NetworkMainnet:
+		return "chainhooks.NetworkMainnet"
+	case NetworkTestnet:
+		return "chainhooks.NetworkTestnet"
+	default:
+		return fmt.Sprintf("chainhooks.Network(%q)", network)
+	}
+}
+
+// goFilterCall returns the builder call that adds a filter. Filters without
+// a dedicated builder method are added with AddFilter.
+func goFilterCall(filter EventFilter) (string, error) {
+	switch f := filter.(type) {
+	case *FTMintFilter:
+		return goCall("AddFTMint", strconv.Quote(string(f.Asset)), goPrincipal(f.Recipient), goAmount(f.Amount, false)), nil
+	case *FTBurnFilter:
+		return goCall("AddFTBurn", strconv.Quote(string(f.Asset)), goPrincipal(f.Sender), goAmount(f.Amount, false)), nil
+	case *FTTransferFilter:
+		return goCall("AddFTTransfer", strconv.Quote(string(f.Asset)), goPrincipal(f.Sender), goPrincipal(f.Recipient), goAmount(f.Amount, false)), nil
+	case *NFTMintFilter:
+		return goCall("AddNFTMint", strconv.Quote(string(f.Asset)), goPrincipal(f.Recipient)), nil
+	case *NFTBurnFilter:
+		return goCall("AddNFTBurn", strconv.Quote(string(f.Asset)), goPrincipal(f.Sender)), nil
+	case *NFTTransferFilter:
+		return goCall("AddNFTTransfer", strconv.Quote(string(f.Asset)), goPrincipal(f.Sender), goPrincipal(f.Recipient)), nil
+	case *STXMintFilter:
+		return goCall("AddSTXMint", goPrincipal(f.Recipient), goAmount(f.Amount, true)), nil
+	case *STXBurnFilter:
+		return goCall("AddSTXBurn", goPrincipal(f.Sender), goAmount(f.Amount, true)), nil
+	case *STXTransferFilter:
+		return goCall("AddSTXTransfer", goPrincipal(f.Sender), goPrincipal(f.Recipient), goAmount(f.Amount, true)), nil
+	case *ContractDeployFilter:
+		return goCall("AddContractDeploy", goPrincipal(f.DeployerPrincipal)), nil
+	case *ContractCallFilter:
+		return goCall("AddContractCall", goString(f.ContractIdentifier), goString(f.Method), goPrincipal(f.Sender)), nil
+	case *ContractLogFilter:
+		return goCall("AddContractLog", goString(f.ContractIdentifier)), nil
+	case *BalanceChangeFilter:
+		return goCall("AddBalanceChange", goPrincipal(f.Principal)), nil
+	case *CoinbaseFilter:
+		return goCall("AddCoinbase", goPrincipal(f.Recipient)), nil
+	case *TenureChangeFilter:
+		return goCall("AddTenureChange"), nil
+	case *FTEventFilter, *NFTEventFilter, *STXEventFilter:
+		return goCall("AddFilter", goFilterLiteral(filter)), nil
+	default:
+		return "", fmt.Errorf("cannot generate code for %s filter of type %T", filter.EventType(), filter)
+	}
+}
+
+func goCall(method string, args ...string) string {
+	return method + "(" + strings.Join(args, ", ") + ")"
+}
+
+// goFilterLiteral returns a composite literal for a filter struct, omitting
+// unset fields.
+func goFilterLiteral(filter EventFilter) string {
+	v := reflect.ValueOf(filter).Elem()
+	t := v.Type()
+	stx := filter.EventType() == EventTypeSTXEvent
+
+	fields := []string{"Type: " + goEventType(filter.EventType())}
+	for _, field := range expressionFields(t) {
+		value := v.Field(field.index)
+		var text string
+		switch value.Type() {
+		case principalPtrType:
+			if value.IsNil() {
+				continue
+			}
+			text = goPrincipal(value.Interface().(*Principal))
+		case amountPtrType:
+			if value.IsNil() {
+				continue
+			}
+			text = goAmount(value.Interface().(*Amount), stx)
+		case stringPtrType:
+			if value.IsNil() {
+				continue
+			}
+			text = goString(value.Interface().(*string))
+		default:
+			if value.String() == "" {
+				continue
+			}
+			text = strconv.Quote(value.String())
+		}
+		fields = append(fields, t.Field(field.index).Name+": "+text)
+	}
+	return "&chainhooks." + t.Name() + "{" + strings.Join(fields, ", ") + "}"
+}
+
+// goEventTypes maps the event types without a builder method to their
+// constants.
+var goEventTypes = map[EventType]string{
+	EventTypeFTEvent:  "EventTypeFTEvent",
+	EventTypeNFTEvent: "EventTypeNFTEvent",
+	EventTypeSTXEvent: "EventTypeSTXEvent",
+}
+
+func goEventType(eventType EventType) string {
+	if name, ok := goEventTypes[eventType]; ok {
+		return "chainhooks." + name
+	}
+	return strconv.Quote(string(eventType))
+}
+
+func goPrincipal(p *Principal) string {
+	switch {
+	case p == nil:
+		return "nil"
+	case p.Standard != nil:
+		return "chainhooks.PrincipalStandard(" + strconv.Quote(*p.Standard) + ")"
+	default:
+		return "chainhooks.PrincipalContract(" + strconv.Quote(*p.Contract) + ")"
+	}
+}
+
+// goAmount returns an amount expression, in micro-STX for STX filters.
+func goAmount(a *Amount, stx bool) string {
+	if a == nil {
+		return "nil"
+	}
+	n, ok := a.Uint64()
+	switch {
+	case !ok:
+		return "chainhooks.AmountPtr(chainhooks.MustParseAmount(" + strconv.Quote(a.String()) + "))"
+	case stx:
+		return fmt.Sprintf("chainhooks.AmountPtr(chainhooks.MicroSTX(%d))", n)
+	default:
+		return fmt.Sprintf("chainhooks.AmountPtr(chainhooks.NewAmount(%d))", n)
+	}
+}
+
+func goString(s *string) string {
+	if s == nil {
+		return "nil"
+	}
+	return "chainhooks.StringPtr(" + strconv.Quote(*s) + ")"
+}
+
+// goOptionCalls returns the builder calls that set each option, in the
+// order of the ChainhookOptions fields.
+func goOptionCalls(opts *ChainhookOptions) []string {
+	if opts == nil {
+		return nil
+	}
+
+	var calls []string
+	boolCall := func(method string, value *bool) {
+		if value != nil {
+			calls = append(calls, goCall(method, strconv.FormatBool(*value)))
+		}
+	}
+	countCall := func(method string, value *uint64) {
+		if value != nil {
+			calls = append(calls, goCall(method, strconv.FormatUint(*value, 10)))
+		}
+	}
+	boolCall("WithEnableOnRegistration", opts.EnableOnRegistration)
+	countCall("WithExpireAfterEvaluations", opts.ExpireAfterEvaluations)
+	countCall("WithExpireAfterOccurrences", opts.ExpireAfterOccurrences)
+	boolCall("WithDecodeClarityValues", opts.DecodeClarityValues)
+	boolCall("WithIncludeContractABI", opts.IncludeContractABI)
+	boolCall("WithIncludeContractSourceCode", opts.IncludeContractSourceCode)
+	boolCall("WithIncludePostConditions", opts.IncludePostConditions)
+	boolCall("WithIncludeRawTransactions", opts.IncludeRawTransactions)
+	boolCall("WithIncludeBlockSignatures", opts.IncludeBlockSignatures)
+	boolCall("WithIncludeBlockMetadata", opts.IncludeBlockMetadata)
+
+	if len(calls) == 0 {
+		// An empty options object is kept as it is.
+		calls = append(calls, "WithOptions(&chainhooks.ChainhookOptions{})")
+	}
+	return calls
+}
@@ -0,0 +1,182 @@
+package chainhooks
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// codegenDefinitions covers every filter type and option.
+func codegenDefinitions() []*ChainhookDefinition {
+	const (
+		address  = "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7"
+		contract = address + ".pool"
+		asset    = address + ".token::tkn"
+	)
+	return []*ChainhookDefinition{
+		NewChainhookBuilder("token-activity", NetworkMainnet).
+			WithWebhookURL("https://example.com/webhook?source=\"ui\"").
+			AddFTMint(asset, PrincipalStandard(address), AmountPtr(NewAmount(5))).
+			AddFTBurn(asset, nil, AmountPtr(MustParseAmount("340282366920938463463374607431768211455"))).
+			AddFTTransfer(asset, PrincipalContract(contract), PrincipalStandard(address), nil).
+			AddNFTMint(asset, nil).
+			AddNFTBurn(asset, PrincipalStandard(address)).
+			AddNFTTransfer(asset, nil, PrincipalContract(contract)).
+			AddFilter(&FTEventFilter{Type: EventTypeFTEvent, Asset: asset, Action: FilterActionMint}).
+			AddFilter(&NFTEventFilter{Type: EventTypeNFTEvent, Asset: asset, Receiver: PrincipalStandard(address)}).
+			WithEnableOnRegistration(false).
+			WithExpireAfterEvaluations(10).
+			WithExpireAfterOccurrences(3).
+			WithDecodeClarityValues(true).
+			WithIncludeContractABI(false).
+			WithIncludeContractSourceCode(false).
+			WithIncludePostConditions(true).
+			WithIncludeRawTransactions(true).
+			WithIncludeBlockSignatures(true).
+			WithIncludeBlockMetadata(true).
+			MustBuild(),
+		NewChainhookBuilder("stx-and-contracts", NetworkMainnet).
+			WithWebhookURL("https://example.com/stx").
+			AddSTXMint(nil, AmountPtr(MicroSTX(1))).
+			AddSTXBurn(PrincipalStandard(address), nil).
+			AddSTXTransfer(nil, nil, AmountPtr(MicroSTX(1_000_000))).
+			AddFilter(&STXEventFilter{Type: EventTypeSTXEvent, Action: FilterActionLock, Amount: AmountPtr(MicroSTX(7))}).
+			AddContractDeploy(PrincipalStandard(address)).
+			AddContractCall(StringPtr(contract), StringPtr("swap-x-for-y"), nil).
+			AddContractLog(nil).
+			AddBalanceChange(PrincipalContract(contract)).
+			AddCoinbase(nil).
+			AddTenureChange().
+			WithOptions(&ChainhookOptions{}).
+			MustBuild(),
+	}
+}
+
+func TestGenerateGoCodeRoundTrip(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds generated code")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found")
+	}
+	repo, err := filepath.Abs(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defs := codegenDefinitions()
+	source, err := GenerateGoCode(defs, &GoCodeOptions{Package: "main"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod":   "module generated\n\ngo 1.21\n\nrequire " + chainhooksImportPath + " v0.0.0\n\nreplace " + chainhooksImportPath + " => " + repo + "\n",
+		"hooks.go": string(source),
+		"main.go": `package main
+
+import (
+	"encoding/json"
+	"os"
+)
+
+func main() {
+	json.NewEncoder(os.Stdout).Encode(Definitions())
+}
+`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := exec.Command(goTool, "run", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off", "GOWORK=off")
+	out, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			t.Logf("%s", exitErr.Stderr)
+		}
+		t.Fatalf("running generated code: %v\n%s", err, source)
+	}
+
+	want, _ := json.Marshal(defs)
+	if got := strings.TrimSpace(string(out)); got != string(want) {
+		t.Fatalf("generated code built a different definition:\ngot  %s\nwant %s\n%s", got, want, source)
+	}
+}
+
+func TestGenerateGoCodeFromChainhooks(t *testing.T) {
+	hooks := []Chainhook{
+		{UUID: "uuid-1", Definition: testDefinition()},
+		{UUID: "uuid-2", Definition: testDefinition()},
+	}
+	hooks[1].Definition.Name = "42"
+
+	source, err := GenerateGoCodeFromChainhooks(hooks, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"package hooks\n",
+		"// MyHook returns the definition of chainhook uuid-1 (\"my-hook\").\nfunc MyHook() *chainhooks.ChainhookDefinition {",
+		"func Chainhook42() *chainhooks.ChainhookDefinition {",
+		"AddSTXTransfer(chainhooks.PrincipalStandard(\"" + testAddress + "\"), nil, nil).",
+		"return []*chainhooks.ChainhookDefinition{\n\t\tMyHook(),\n\t\tChainhook42(),\n\t}",
+	} {
+		if !strings.Contains(string(source), want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, source)
+		}
+	}
+}
+
+func TestGenerateGoCodeNames(t *testing.T) {
+	var defs []*ChainhookDefinition
+	for _, name := range []string{"whale alerts", "whale-alerts", "definitions", "ünïcode_hook"} {
+		def := testDefinition()
+		def.Name = name
+		defs = append(defs, def)
+	}
+	source, err := GenerateGoCode(defs, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"func WhaleAlerts()", "func WhaleAlerts2()", "func Definitions2()", "func ÜnïcodeHook()"} {
+		if !strings.Contains(string(source), want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, source)
+		}
+	}
+}
+
+func TestGenerateGoCodeErrors(t *testing.T) {
+	invalid := testDefinition()
+	invalid.Action.URL = ""
+	version := testDefinition()
+	version.Version = "2"
+
+	tests := []struct {
+		name string
+		defs []*ChainhookDefinition
+		opts *GoCodeOptions
+	}{
+		{"nil definition", []*ChainhookDefinition{nil}, nil},
+		{"invalid definition", []*ChainhookDefinition{invalid}, nil},
+		{"unsupported version", []*ChainhookDefinition{version}, nil},
+		{"invalid package", []*ChainhookDefinition{testDefinition()}, &GoCodeOptions{Package: "my-hooks"}},
+		{"package shadows import", []*ChainhookDefinition{testDefinition()}, &GoCodeOptions{Package: "chainhooks"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := GenerateGoCode(tt.defs, tt.opts); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
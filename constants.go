@@ -10,6 +10,9 @@ var ChainhooksBaseURLs = map[Network]string{
 const (
 	DefaultAPIVersion = "1"
 	DefaultChain      = ChainStacks
+	DefaultPageSize   = 20
+
+	DefaultMaxConcurrency = 4
 )
 
 // HTTP methods
@@ -37,9 +40,25 @@ const (
 	HeaderContentType   = "Content-Type"
 	HeaderAuthorization = "Authorization"
 	HeaderAPIKey        = "x-api-key"
+	HeaderETag          = "ETag"
+	HeaderIfNoneMatch   = "If-None-Match"
 )
 
 // Header values
 const (
 	ContentTypeJSON = "application/json"
 )
+
+// Action types
+const (
+	ActionTypeHTTPPost = "http_post"
+)
+
+// Event filter actions for FTEventFilter, NFTEventFilter and STXEventFilter.
+// An empty action matches every action.
+const (
+	FilterActionMint     = "mint"
+	FilterActionBurn     = "burn"
+	FilterActionTransfer = "transfer"
+	FilterActionLock     = "lock" // STXEventFilter only
+)
@@ -0,0 +1,391 @@
+package chainhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ============================================================================
+// Definition Diff
+// ============================================================================
+
+// ChangeKind describes how a part of a definition changed.
+type ChangeKind string
+
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeModified ChangeKind = "modified"
+)
+
+// DefinitionChange is a single semantic difference between two definitions.
+type DefinitionChange struct {
+	Kind ChangeKind
+	// Path is the JSON path of the changed field, such as "action.url" or
+	// "options.decode_clarity_values". Filter changes use "filters.events".
+	Path string
+	// Old and New hold the values before and after the change. For filter
+	// changes they hold an EventFilter; for an added filter Old is nil and
+	// for a removed filter New is nil.
+	Old interface{}
+	New interface{}
+}
+
+// String describes the change on a single line.
+func (c DefinitionChange) String() string {
+	switch c.Kind {
+	case ChangeAdded:
+		return fmt.Sprintf("%s: added %s", c.Path, formatDiffValue(c.New))
+	case ChangeRemoved:
+		return fmt.Sprintf("%s: removed %s", c.Path, formatDiffValue(c.Old))
+	default:
+		return fmt.Sprintf("%s: %s -> %s", c.Path, formatDiffValue(c.Old), formatDiffValue(c.New))
+	}
+}
+
+// DefinitionDiff is the list of semantic differences between two definitions.
+type DefinitionDiff struct {
+	Changes []DefinitionChange
+
+	// from and to are the normalized lines of the compared definitions,
+	// rendered by Unified.
+	from, to []diffLine
+}
+
+// diffLine is one line of a normalized definition. Lines with equal keys are
+// semantically equal, even if their text differs.
+type diffLine struct {
+	key  string
+	text string
+}
+
+// Empty reports whether the definitions are semantically identical.
+func (d *DefinitionDiff) Empty() bool {
+	return len(d.Changes) == 0
+}
+
+// String renders the diff in unified format between "a" and "b".
+func (d *DefinitionDiff) String() string {
+	return d.Unified("a", "b")
+}
+
+// diffContext is the number of unchanged lines shown around each change.
+const diffContext = 3
+
+// Unified renders the diff as a unified diff, with fromName and toName as
+// the file headers. Each definition is rendered one field per line, as
+// "path: value", with options in declaration order and filters sorted by
+// their canonical encoding, so the hunks only show semantic changes.
+func (d *DefinitionDiff) Unified(fromName, toName string) string {
+	if d.Empty() {
+		return ""
+	}
+
+	ops := diffLines(d.from, d.to)
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromName, toName)
+	for start := 0; start < len(ops); {
+		// Find the next change and extend the hunk while changes are close
+		first := start
+		for first < len(ops) && ops[first].kind == ' ' {
+			first++
+		}
+		if first == len(ops) {
+			break
+		}
+		last := first
+		for next := first + 1; next < len(ops); next++ {
+			if ops[next].kind == ' ' {
+				continue
+			}
+			if next-last-1 > 2*diffContext {
+				break
+			}
+			last = next
+		}
+		begin := max(first-diffContext, start)
+		end := min(last+diffContext+1, len(ops))
+		writeHunk(&sb, ops, begin, end)
+		start = end
+	}
+	return sb.String()
+}
+
+// diffOp is a line of a unified diff: ' ' for context, '-' for a removed
+// line and '+' for an added one. fromLine and toLine count the lines of each
+// side that precede it.
+type diffOp struct {
+	kind             byte
+	text             string
+	fromLine, toLine int
+}
+
+// diffLines returns the shortest edit script turning from into to, based on
+// their longest common subsequence.
+func diffLines(from, to []diffLine) []diffOp {
+	lcs := make([][]int, len(from)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(to)+1)
+	}
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i].key == to[j].key {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < len(from) || j < len(to) {
+		switch {
+		case i < len(from) && j < len(to) && from[i].key == to[j].key:
+			ops = append(ops, diffOp{kind: ' ', text: to[j].text, fromLine: i, toLine: j})
+			i++
+			j++
+		case j == len(to) || (i < len(from) && lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, diffOp{kind: '-', text: from[i].text, fromLine: i, toLine: j})
+			i++
+		default:
+			ops = append(ops, diffOp{kind: '+', text: to[j].text, fromLine: i, toLine: j})
+			j++
+		}
+	}
+	return ops
+}
+
+// writeHunk writes ops[begin:end] as a hunk with its "@@" header.
+func writeHunk(sb *strings.Builder, ops []diffOp, begin, end int) {
+	var fromCount, toCount int
+	for _, op := range ops[begin:end] {
+		if op.kind != '+' {
+			fromCount++
+		}
+		if op.kind != '-' {
+			toCount++
+		}
+	}
+	fmt.Fprintf(sb, "@@ -%s +%s @@\n",
+		hunkRange(ops[begin].fromLine, fromCount), hunkRange(ops[begin].toLine, toCount))
+	for _, op := range ops[begin:end] {
+		fmt.Fprintf(sb, "%c%s\n", op.kind, op.text)
+	}
+}
+
+// hunkRange formats the line range of one side of a hunk that starts after
+// skipped lines, omitting the count when it is 1 as diff -u does.
+func hunkRange(skipped, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", skipped)
+	case 1:
+		return fmt.Sprintf("%d", skipped+1)
+	default:
+		return fmt.Sprintf("%d,%d", skipped+1, count)
+	}
+}
+
+// definitionLines renders a definition as the lines compared by Unified.
+func definitionLines(def *ChainhookDefinition) []diffLine {
+	var lines []diffLine
+	field := func(path string, value interface{}) {
+		text := path + ": " + formatDiffValue(value)
+		lines = append(lines, diffLine{key: text, text: text})
+	}
+	field("name", def.Name)
+	field("version", def.Version)
+	field("chain", def.Chain)
+	field("network", def.Network)
+	field("action.type", def.Action.Type)
+	field("action.url", def.Action.URL)
+	for _, option := range optionFields {
+		field("options."+option.name, option.get(def.Options))
+	}
+
+	// Filters are sorted by event type, then by canonical encoding
+	type sortedFilter struct {
+		order string
+		line  diffLine
+	}
+	filters := make([]sortedFilter, 0, len(def.Filters.Events))
+	for _, filter := range def.Filters.Events {
+		key := filterKey(filter)
+		order := key
+		if filter != nil {
+			order = string(filter.EventType()) + "\x00" + key
+		}
+		filters = append(filters, sortedFilter{order: order, line: diffLine{
+			key:  "filters.events: " + key,
+			text: "filters.events: " + formatDiffValue(filter),
+		}})
+	}
+	sort.SliceStable(filters, func(i, j int) bool { return filters[i].order < filters[j].order })
+	for _, filter := range filters {
+		lines = append(lines, filter.line)
+	}
+	return lines
+}
+
+// DiffDefinitions compares two definitions semantically and returns the
+// changes needed to turn a into b.
+//
+// Filters are compared as a multiset, so their order does not matter, and
+// each filter is compared by its canonical encoding (see Canonical), so its
+// Type field and the spelling of its addresses are ignored. Unset boolean
+// options are treated as false and nil Options as empty options. A nil
+// definition is treated as an empty one.
+func DiffDefinitions(a, b *ChainhookDefinition) *DefinitionDiff {
+	if a == nil {
+		a = &ChainhookDefinition{}
+	}
+	if b == nil {
+		b = &ChainhookDefinition{}
+	}
+
+	d := &DefinitionDiff{from: definitionLines(a), to: definitionLines(b)}
+	d.compare("name", a.Name, b.Name)
+	d.compare("version", a.Version, b.Version)
+	d.compare("chain", a.Chain, b.Chain)
+	d.compare("network", a.Network, b.Network)
+	d.compare("action.type", a.Action.Type, b.Action.Type)
+	d.compare("action.url", a.Action.URL, b.Action.URL)
+
+	for _, field := range optionFields {
+		d.compare("options."+field.name, field.get(a.Options), field.get(b.Options))
+	}
+
+	d.diffFilters(a.Filters.Events, b.Filters.Events)
+	return d
+}
+
+// compare records a modification when old and new differ.
+func (d *DefinitionDiff) compare(path string, old, new interface{}) {
+	if old != new {
+		d.Changes = append(d.Changes, DefinitionChange{
+			Kind: ChangeModified,
+			Path: path,
+			Old:  old,
+			New:  new,
+		})
+	}
+}
+
+// diffFilters records removed and added filters, matching equal filters
+// regardless of their position.
+func (d *DefinitionDiff) diffFilters(a, b []EventFilter) {
+	inB := countFilters(b)
+	for _, filter := range a {
+		key := filterKey(filter)
+		if inB[key] > 0 {
+			inB[key]--
+			continue
+		}
+		d.Changes = append(d.Changes, DefinitionChange{
+			Kind: ChangeRemoved,
+			Path: "filters.events",
+			Old:  filter,
+		})
+	}
+
+	inA := countFilters(a)
+	for _, filter := range b {
+		key := filterKey(filter)
+		if inA[key] > 0 {
+			inA[key]--
+			continue
+		}
+		d.Changes = append(d.Changes, DefinitionChange{
+			Kind: ChangeAdded,
+			Path: "filters.events",
+			New:  filter,
+		})
+	}
+}
+
+// countFilters counts the occurrences of each filter by key.
+func countFilters(filters []EventFilter) map[string]int {
+	counts := make(map[string]int, len(filters))
+	for _, filter := range filters {
+		counts[filterKey(filter)]++
+	}
+	return counts
+}
+
+// filterKey returns a comparable encoding of filter, normalized so that
+// filters differing only in principal spelling compare equal.
+func filterKey(filter EventFilter) string {
+	data, err := canonicalFilterJSON(filter)
+	if err != nil {
+		return fmt.Sprintf("%#v", filter)
+	}
+	return string(data)
+}
+
+// formatDiffValue renders a changed value for display.
+func formatDiffValue(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return "<unset>"
+	case EventFilter:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Sprintf("%#v", value)
+		}
+		return string(data)
+	case string:
+		return fmt.Sprintf("%q", value)
+	case Chain, Network:
+		return fmt.Sprintf("%q", value)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+// ============================================================================
+// Option Fields
+// ============================================================================
+
+// optionField reads one option in its normalized form: booleans default to
+// false and unset counts to nil.
+type optionField struct {
+	name string
+	get  func(opts *ChainhookOptions) interface{}
+}
+
+// optionFields lists every ChainhookOptions field in declaration order.
+var optionFields = []optionField{
+	boolOption("enable_on_registration", func(o *ChainhookOptions) *bool { return o.EnableOnRegistration }),
+	countOption("expire_after_evaluations", func(o *ChainhookOptions) *uint64 { return o.ExpireAfterEvaluations }),
+	countOption("expire_after_occurrences", func(o *ChainhookOptions) *uint64 { return o.ExpireAfterOccurrences }),
+	boolOption("decode_clarity_values", func(o *ChainhookOptions) *bool { return o.DecodeClarityValues }),
+	boolOption("include_contract_abi", func(o *ChainhookOptions) *bool { return o.IncludeContractABI }),
+	boolOption("include_contract_source_code", func(o *ChainhookOptions) *bool { return o.IncludeContractSourceCode }),
+	boolOption("include_post_conditions", func(o *ChainhookOptions) *bool { return o.IncludePostConditions }),
+	boolOption("include_raw_transactions", func(o *ChainhookOptions) *bool { return o.IncludeRawTransactions }),
+	boolOption("include_block_signatures", func(o *ChainhookOptions) *bool { return o.IncludeBlockSignatures }),
+	boolOption("include_block_metadata", func(o *ChainhookOptions) *bool { return o.IncludeBlockMetadata }),
+}
+
+// boolOption reads a boolean option, treating unset as false.
+func boolOption(name string, field func(*ChainhookOptions) *bool) optionField {
+	return optionField{name: name, get: func(opts *ChainhookOptions) interface{} {
+		if opts == nil || field(opts) == nil {
+			return false
+		}
+		return *field(opts)
+	}}
+}
+
+// countOption reads a count option, returning nil if it is unset.
+func countOption(name string, field func(*ChainhookOptions) *uint64) optionField {
+	return optionField{name: name, get: func(opts *ChainhookOptions) interface{} {
+		if opts == nil || field(opts) == nil {
+			return nil
+		}
+		return *field(opts)
+	}}
+}
@@ -0,0 +1,77 @@
+package chainhooks
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDefinitionDiffUnifiedHunks(t *testing.T) {
+	a := testDefinition()
+	b := testDefinition()
+	b.Name = "renamed"
+	b.Filters = NewChainhookFilters(append(b.Filters.Events, &CoinbaseFilter{})...)
+
+	got := DiffDefinitions(a, b).Unified("old.json", "new.json")
+	want := strings.Join([]string{
+		"--- old.json",
+		"+++ new.json",
+		"@@ -1,4 +1,4 @@",
+		`-name: "my-hook"`,
+		`+name: "renamed"`,
+		` version: "1"`,
+		` chain: "stacks"`,
+		` network: "mainnet"`,
+		"@@ -14,5 +14,6 @@",
+		" options.include_raw_transactions: false",
+		" options.include_block_signatures: false",
+		" options.include_block_metadata: false",
+		`+filters.events: {"type":"coinbase"}`,
+		` filters.events: {"type":"contract_deploy"}`,
+		` filters.events: {"type":"stx_transfer","sender":{"standard":"SP000000000000000000002Q6VF78"}}`,
+		"",
+	}, "\n")
+	if got != want {
+		t.Fatalf("unexpected diff:\n%s", got)
+	}
+
+	if diff := DiffDefinitions(a, testDefinition()); diff.String() != "" {
+		t.Fatalf("expected no output for equal definitions, got:\n%s", diff)
+	}
+}
+
+func TestDefinitionDiffChanges(t *testing.T) {
+	transfer := &STXTransferFilter{Sender: PrincipalStandard(testAddress)}
+	deploy := &ContractDeployFilter{}
+	coinbase := &CoinbaseFilter{}
+	a := testDefinition()
+	a.Filters = NewChainhookFilters(transfer, deploy)
+	a.Options = &ChainhookOptions{IncludeRawTransactions: BoolPtr(true), ExpireAfterEvaluations: Uint64Ptr(10)}
+
+	b := testDefinition()
+	b.Action.URL = "https://example.com/other"
+	b.Filters = NewChainhookFilters(coinbase, &ContractDeployFilter{Type: EventTypeContractDeploy})
+	b.Options = &ChainhookOptions{DecodeClarityValues: BoolPtr(true), ExpireAfterOccurrences: Uint64Ptr(5)}
+
+	want := []DefinitionChange{
+		{Kind: ChangeModified, Path: "action.url", Old: "https://example.com/webhook", New: "https://example.com/other"},
+		{Kind: ChangeModified, Path: "options.expire_after_evaluations", Old: uint64(10), New: nil},
+		{Kind: ChangeModified, Path: "options.expire_after_occurrences", Old: nil, New: uint64(5)},
+		{Kind: ChangeModified, Path: "options.decode_clarity_values", Old: false, New: true},
+		{Kind: ChangeModified, Path: "options.include_raw_transactions", Old: true, New: false},
+		{Kind: ChangeRemoved, Path: "filters.events", Old: transfer},
+		{Kind: ChangeAdded, Path: "filters.events", New: coinbase},
+	}
+	diff := DiffDefinitions(a, b)
+	if !reflect.DeepEqual(diff.Changes, want) {
+		t.Fatalf("got changes:\n%v\nwant:\n%v", diff.Changes, want)
+	}
+
+	// Reordered filters and unset options equal to their defaults are not changes
+	c := testDefinition()
+	c.Filters = NewChainhookFilters(c.Filters.Events[1], c.Filters.Events[0])
+	c.Options = &ChainhookOptions{IncludeBlockMetadata: BoolPtr(false)}
+	if diff := DiffDefinitions(testDefinition(), c); !diff.Empty() {
+		t.Fatalf("expected no changes, got %v", diff.Changes)
+	}
+}
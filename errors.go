@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 )
 
 // HttpError represents an HTTP error response from the Chainhooks API.
@@ -88,6 +89,30 @@ func (e *ValidationError) Error() string {
 	return fmt.Sprintf("validation error on field '%s': %s", e.Field, e.Reason)
 }
 
+// ValidationErrors is a list of validation errors reported together.
+type ValidationErrors []*ValidationError
+
+// Error implements the error interface.
+func (e ValidationErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = fmt.Sprintf("%s: %s", err.Field, err.Reason)
+	}
+	return fmt.Sprintf("%d validation errors: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the individual validation errors.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
 // ConfigError represents a configuration error.
 type ConfigError struct {
 	Message string
@@ -97,3 +122,117 @@ type ConfigError struct {
 func (e *ConfigError) Error() string {
 	return fmt.Sprintf("config error: %s", e.Message)
 }
+
+// FingerprintMismatchError is returned when a patch is applied to a
+// definition whose fingerprint differs from the expected one, meaning the
+// chainhook was changed since it was read.
+type FingerprintMismatchError struct {
+	Expected string
+	Actual   string
+}
+
+// Error implements the error interface.
+func (e *FingerprintMismatchError) Error() string {
+	return fmt.Sprintf("definition fingerprint mismatch: expected %s, got %s", e.Expected, e.Actual)
+}
+
+// BulkEnableCountError is returned by BulkEnableChainhooks when the number
+// of chainhooks a request changes differs from its ExpectedCount.
+type BulkEnableCountError struct {
+	Expected uint64
+	Actual   uint64
+	// Applied is false when the preview diverged and no request was sent,
+	// and true when the server applied the request but reported a different
+	// count.
+	Applied bool
+}
+
+// Error implements the error interface.
+func (e *BulkEnableCountError) Error() string {
+	if e.Applied {
+		return fmt.Sprintf("bulk enable expected to change %d chainhooks, but the server updated %d", e.Expected, e.Actual)
+	}
+	return fmt.Sprintf("bulk enable expected to change %d chainhooks, but %d would change; no request was sent", e.Expected, e.Actual)
+}
+
+// EventFilterDecodeError is returned when a filter of a known event type
+// holds a value its filter struct cannot represent, such as a fractional
+// amount.
+type EventFilterDecodeError struct {
+	// Index is the position of the filter in filters.events, or -1 when the
+	// filter was decoded on its own.
+	Index int
+	Type  EventType
+	// Field is the path of the offending field within the filter, such as
+	// "amount", or empty if it could not be determined.
+	Field string
+	Err   error
+}
+
+// Error implements the error interface.
+func (e *EventFilterDecodeError) Error() string {
+	var path []string
+	if e.Index >= 0 {
+		path = append(path, fmt.Sprintf("filters.events[%d]", e.Index))
+	}
+	if e.Field != "" {
+		path = append(path, e.Field)
+	}
+	if len(path) == 0 {
+		return fmt.Sprintf("invalid %s filter: %v", e.Type, e.Err)
+	}
+	return fmt.Sprintf("%s: invalid %s filter: %v", strings.Join(path, "."), e.Type, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *EventFilterDecodeError) Unwrap() error {
+	return e.Err
+}
+
+// BuilderError is a problem recorded by a ChainhookBuilder method, such as an
+// invalid argument.
+type BuilderError struct {
+	// Method is the builder method that recorded the error, such as
+	// "AddFTTransfer", or "Build" for problems found when validating the
+	// assembled definition.
+	Method string
+	Err    error
+
+	// field is the definition field the error covers, so that it is not
+	// reported twice.
+	field string
+}
+
+// Error implements the error interface.
+func (e *BuilderError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Method, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *BuilderError) Unwrap() error {
+	return e.Err
+}
+
+// BuilderErrors is the list of problems reported by ChainhookBuilder.Build.
+type BuilderErrors []*BuilderError
+
+// Error implements the error interface.
+func (e BuilderErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d builder errors: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the individual builder errors.
+func (e BuilderErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
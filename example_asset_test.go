@@ -0,0 +1,28 @@
+package chainhooks
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ExampleParseAssetIdentifier demonstrates parsing an asset identifier into its parts.
+func ExampleParseAssetIdentifier() {
+	asset, err := ParseAssetIdentifier("SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7.my-token::tkn")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(asset.Contract())
+	fmt.Println(asset.ContractName(), asset.AssetName())
+
+	filter, _ := json.Marshal(&FTTransferFilter{Asset: asset})
+	fmt.Println(string(filter))
+
+	_, err = ParseAssetIdentifier("SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7.my-token:tkn")
+	fmt.Println(err != nil)
+	// Output:
+	// SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7.my-token
+	// my-token tkn
+	// {"type":"ft_transfer","asset":"SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7.my-token::tkn"}
+	// true
+}
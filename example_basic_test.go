@@ -2,6 +2,7 @@ package chainhooks
 
 import (
 	"context"
+	"fmt"
 )
 
 // ExampleNewClient demonstrates basic client creation.
@@ -17,7 +18,7 @@ func ExampleNewChainhookBuilder() {
 	definition, err := NewChainhookBuilder("stx-transfer-hook", NetworkMainnet).
 		WithWebhookURL("https://example.com/webhook").
 		AddSTXTransfer(
-			PrincipalStandard("SP..."),
+			PrincipalStandard("SP000000000000000000002Q6VF78"),
 			nil,
 			nil,
 		).
@@ -32,6 +33,35 @@ func ExampleNewChainhookBuilder() {
 	// Output:
 }
 
+// ExampleBuilderFrom demonstrates deriving a testnet copy of a mainnet chainhook.
+func ExampleBuilderFrom() {
+	transfers := &STXTransferFilter{Sender: PrincipalStandard("SP000000000000000000002Q6VF78")}
+	prod, _ := NewChainhookBuilder("my-hook", NetworkMainnet).
+		WithWebhookURL("https://example.com/webhook").
+		AddFilter(transfers).
+		AddContractDeploy(nil).
+		Build()
+
+	testnet, err := BuilderFrom(prod).
+		WithName("my-hook-testnet").
+		WithNetwork(NetworkTestnet).
+		RemoveFilter(transfers).
+		AddNFTBurn("ST000000000000000000002AMW42H.collection::nft", nil).
+		Build()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, filter := range testnet.Filters.Events {
+		fmt.Println(filter.EventType())
+	}
+	fmt.Println(len(prod.Filters.Events), prod.Network)
+	// Output:
+	// contract_deploy
+	// nft_burn
+	// 2 mainnet
+}
+
 // ExampleClient_RegisterChainhook demonstrates registering a chainhook.
 func ExampleClient_RegisterChainhook() {
 	client := NewClient(ChainhooksBaseURLs[NetworkMainnet])
@@ -43,7 +73,7 @@ func ExampleClient_RegisterChainhook() {
 		Chain:   ChainStacks,
 		Network: NetworkMainnet,
 		Filters: ChainhookFilters{
-			Events: []interface{}{
+			Events: []EventFilter{
 				&STXTransferFilter{
 					Type: EventTypeSTXTransfer,
 				},
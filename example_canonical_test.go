@@ -0,0 +1,41 @@
+package chainhooks
+
+import "fmt"
+
+// ExampleChainhookDefinition_Fingerprint demonstrates that equivalent definitions share a fingerprint.
+func ExampleChainhookDefinition_Fingerprint() {
+	a := &ChainhookDefinition{
+		Name:    "my-hook",
+		Version: DefaultAPIVersion,
+		Chain:   ChainStacks,
+		Network: NetworkMainnet,
+		Filters: NewChainhookFilters(
+			&STXTransferFilter{Sender: PrincipalStandard("SP000000000000000000002Q6VF78")},
+			&ContractDeployFilter{},
+		),
+		Action: ChainhookAction{Type: ActionTypeHTTPPost, URL: "https://example.com/webhook"},
+	}
+	b := &ChainhookDefinition{
+		Name:    "my-hook",
+		Version: DefaultAPIVersion,
+		Chain:   ChainStacks,
+		Network: NetworkMainnet,
+		// Reordered filters, a lower-case address and an explicit default option.
+		Filters: NewChainhookFilters(
+			&ContractDeployFilter{},
+			&STXTransferFilter{Sender: PrincipalStandard("sp000000000000000000002q6vf78")},
+		),
+		Options: &ChainhookOptions{DecodeClarityValues: BoolPtr(false)},
+		Action:  ChainhookAction{Type: ActionTypeHTTPPost, URL: "https://example.com/webhook"},
+	}
+
+	fa, _ := a.Fingerprint()
+	fb, _ := b.Fingerprint()
+	fmt.Println(fa == fb)
+
+	data, _ := b.CanonicalJSON()
+	fmt.Println(string(data))
+	// Output:
+	// true
+	// {"name":"my-hook","version":"1","chain":"stacks","network":"mainnet","filters":{"events":[{"type":"contract_deploy"},{"type":"stx_transfer","sender":{"standard":"SP000000000000000000002Q6VF78"}}]},"action":{"type":"http_post","url":"https://example.com/webhook"}}
+}
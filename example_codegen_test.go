@@ -0,0 +1,42 @@
+package chainhooks
+
+import "fmt"
+
+// ExampleGenerateGoCode demonstrates turning an existing definition into builder code.
+func ExampleGenerateGoCode() {
+	definition := &ChainhookDefinition{
+		Name:    "whale-alerts",
+		Version: DefaultAPIVersion,
+		Chain:   ChainStacks,
+		Network: NetworkMainnet,
+		Filters: NewChainhookFilters(&STXTransferFilter{Amount: AmountPtr(MicroSTX(1_000_000_000_000))}),
+		Options: &ChainhookOptions{DecodeClarityValues: BoolPtr(true)},
+		Action:  ChainhookAction{Type: ActionTypeHTTPPost, URL: "https://example.com/webhook"},
+	}
+
+	source, err := GenerateGoCode([]*ChainhookDefinition{definition}, &GoCodeOptions{Package: "hooks"})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Print(string(source))
+	// Output:
+	// package hooks
+	//
+	// import "github.com/tony1908/chainhooks-client-go"
+	//
+	// // WhaleAlerts returns the "whale-alerts" chainhook definition.
+	// func WhaleAlerts() *chainhooks.ChainhookDefinition {
+	// 	return chainhooks.NewChainhookBuilder("whale-alerts", chainhooks.NetworkMainnet).
+	// 		WithWebhookURL("https://example.com/webhook").
+	// 		AddSTXTransfer(nil, nil, chainhooks.AmountPtr(chainhooks.MicroSTX(1000000000000))).
+	// 		WithDecodeClarityValues(true).
+	// 		MustBuild()
+	// }
+	//
+	// // Definitions returns every generated definition.
+	// func Definitions() []*chainhooks.ChainhookDefinition {
+	// 	return []*chainhooks.ChainhookDefinition{
+	// 		WhaleAlerts(),
+	// 	}
+	// }
+}
@@ -0,0 +1,49 @@
+package chainhooks
+
+import "fmt"
+
+// ExampleDiffDefinitions demonstrates comparing two definitions semantically.
+func ExampleDiffDefinitions() {
+	stx := &STXTransferFilter{Sender: PrincipalStandard("SP000000000000000000002Q6VF78")}
+	deploy := &ContractDeployFilter{}
+
+	a := &ChainhookDefinition{
+		Name:    "my-hook",
+		Network: NetworkMainnet,
+		Filters: NewChainhookFilters(stx, deploy),
+		Action:  ChainhookAction{Type: ActionTypeHTTPPost, URL: "https://old.example.com/webhook"},
+	}
+	b := &ChainhookDefinition{
+		Name:    "my-hook",
+		Network: NetworkMainnet,
+		// Reordered, one filter removed and one added.
+		Filters: NewChainhookFilters(&CoinbaseFilter{}, stx),
+		Options: &ChainhookOptions{DecodeClarityValues: BoolPtr(true), IncludeBlockMetadata: BoolPtr(false)},
+		Action:  ChainhookAction{Type: ActionTypeHTTPPost, URL: "https://new.example.com/webhook"},
+	}
+
+	fmt.Print(DiffDefinitions(a, b))
+	// Output:
+	// --- a
+	// +++ b
+	// @@ -3,16 +3,16 @@
+	//  chain: ""
+	//  network: "mainnet"
+	//  action.type: "http_post"
+	// -action.url: "https://old.example.com/webhook"
+	// +action.url: "https://new.example.com/webhook"
+	//  options.enable_on_registration: false
+	//  options.expire_after_evaluations: <unset>
+	//  options.expire_after_occurrences: <unset>
+	// -options.decode_clarity_values: false
+	// +options.decode_clarity_values: true
+	//  options.include_contract_abi: false
+	//  options.include_contract_source_code: false
+	//  options.include_post_conditions: false
+	//  options.include_raw_transactions: false
+	//  options.include_block_signatures: false
+	//  options.include_block_metadata: false
+	// -filters.events: {"type":"contract_deploy"}
+	// +filters.events: {"type":"coinbase"}
+	//  filters.events: {"type":"stx_transfer","sender":{"standard":"SP000000000000000000002Q6VF78"}}
+}
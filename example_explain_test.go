@@ -0,0 +1,18 @@
+package chainhooks
+
+import "fmt"
+
+// ExampleExplain demonstrates summarizing a definition in plain English.
+func ExampleExplain() {
+	definition := NewChainhookBuilder("token-transfers", NetworkMainnet).
+		WithWebhookURL("https://example.com/webhook").
+		AddFTTransfer("SP000000000000000000002Q6VF78.token::tkn", PrincipalStandard("SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7"), nil, nil).
+		AddSTXTransfer(nil, nil, AmountPtr(MicroSTX(250_000_000))).
+		WithDecodeClarityValues(true).
+		WithExpireAfterOccurrences(100).
+		MustBuild()
+
+	fmt.Println(Explain(definition))
+	// Output:
+	// On mainnet, POST to https://example.com/webhook whenever SP000000000000000000002Q6VF78.token::tkn is transferred from SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7 or STX is transferred with an amount of exactly 250 STX, including decoded Clarity values; expires after 100 occurrences.
+}
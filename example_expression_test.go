@@ -0,0 +1,28 @@
+package chainhooks
+
+import "fmt"
+
+// ExampleParseFilterExpression demonstrates parsing filters from text and printing them back.
+func ExampleParseFilterExpression() {
+	filters, err := ParseFilterExpression(
+		"contract_call(contract=SP000000000000000000002Q6VF78.pool, method=swap-x-for-y) or " +
+			"ft_burn(asset=SP000000000000000000002Q6VF78.token::tkn, amount=1000000)",
+	)
+	if err != nil {
+		panic(err)
+	}
+	for _, filter := range filters {
+		fmt.Println(filter.EventType())
+	}
+
+	text, _ := FormatFilterExpression(filters)
+	fmt.Println(text)
+
+	_, err = ParseFilterExpression("stx_transfer(amount=lots)")
+	fmt.Println(err)
+	// Output:
+	// contract_call
+	// ft_burn
+	// contract_call(contract=SP000000000000000000002Q6VF78.pool, method=swap-x-for-y) or ft_burn(asset=SP000000000000000000002Q6VF78.token::tkn, amount=1000000)
+	// filter expression: invalid amount: amount "lots" must be an unsigned integer at offset 20
+}
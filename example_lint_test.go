@@ -0,0 +1,33 @@
+package chainhooks
+
+import "fmt"
+
+// ExampleLint demonstrates reporting overlapping and ineffective filters.
+func ExampleLint() {
+	const (
+		addr  = "SP000000000000000000002Q6VF78"
+		asset = AssetIdentifier(addr + ".token::tkn")
+	)
+	definition := &ChainhookDefinition{
+		Filters: NewChainhookFilters(
+			&FTEventFilter{Asset: asset},
+			&FTTransferFilter{Asset: asset, Sender: PrincipalStandard(addr)},
+			&STXTransferFilter{},
+			&STXTransferFilter{Sender: PrincipalStandard(addr)},
+			&STXTransferFilter{Sender: PrincipalStandard(addr), Recipient: PrincipalStandard(addr)},
+			&FTMintFilter{Asset: asset, Amount: AmountPtr(NewAmount(0))},
+			&ContractDeployFilter{},
+		),
+		Options: &ChainhookOptions{IncludeContractSourceCode: BoolPtr(true)},
+	}
+
+	for _, finding := range Lint(definition) {
+		fmt.Println(finding.Severity, finding.Rule, finding.Path)
+	}
+	// Output:
+	// error never-matches filters.events[4]
+	// error never-matches filters.events[5].amount
+	// warning subsumed-filter filters.events[1]
+	// warning subsumed-filter filters.events[3]
+	// warning broad-filter-heavy-options options.include_contract_source_code
+}
@@ -0,0 +1,36 @@
+package chainhooks
+
+import "fmt"
+
+// ExampleMigrationRegistry demonstrates upgrading a stored definition written for an older schema.
+func ExampleMigrationRegistry() {
+	registry := NewMigrationRegistry()
+	registry.Register("0", "1", func(doc map[string]interface{}) ([]LossyChange, error) {
+		doc["action"] = map[string]interface{}{"type": ActionTypeHTTPPost, "url": doc["webhook_url"]}
+		delete(doc, "webhook_url")
+		delete(doc, "comment")
+		return []LossyChange{{Path: "comment", Reason: "comments are not supported"}}, nil
+	})
+
+	stored := []byte(`{
+		"name": "deployments",
+		"version": "0",
+		"chain": "stacks",
+		"network": "mainnet",
+		"webhook_url": "https://example.com/webhook",
+		"comment": "all deployments",
+		"filters": {"events": [{"type": "contract_deploy"}]}
+	}`)
+
+	definition, report, err := registry.DecodeDefinition(stored)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(definition.Version, definition.Action.URL)
+	for _, change := range report.Lossy {
+		fmt.Println(change)
+	}
+	// Output:
+	// 1 https://example.com/webhook
+	// 0 -> 1: comment: comments are not supported
+}
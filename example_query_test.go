@@ -0,0 +1,42 @@
+package chainhooks
+
+import (
+	"fmt"
+)
+
+// ExampleNewChainhookQuery demonstrates matching chainhooks client-side.
+func ExampleNewChainhookQuery() {
+	query := NewChainhookQuery().
+		NameGlob("indexer-*").
+		Network(NetworkMainnet).
+		Status(ChainhookStatusInterrupted).
+		WebhookHost("hooks.example.com")
+
+	hooks := []Chainhook{
+		{
+			UUID: "uuid-1",
+			Definition: &ChainhookDefinition{
+				Name:    "indexer-tokens",
+				Network: NetworkMainnet,
+				Action:  ChainhookAction{Type: "http_post", URL: "https://hooks.example.com:8443/tokens"},
+			},
+			Status: ChainhookStatusInfo{Status: ChainhookStatusInterrupted},
+		},
+		{
+			UUID: "uuid-2",
+			Definition: &ChainhookDefinition{
+				Name:    "indexer-nfts",
+				Network: NetworkTestnet,
+				Action:  ChainhookAction{Type: "http_post", URL: "https://hooks.example.com/nfts"},
+			},
+			Status: ChainhookStatusInfo{Status: ChainhookStatusInterrupted},
+		},
+	}
+
+	for i := range hooks {
+		fmt.Println(hooks[i].UUID, query.Match(&hooks[i]))
+	}
+	// Output:
+	// uuid-1 true
+	// uuid-2 false
+}
@@ -0,0 +1,69 @@
+package chainhooks
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ExampleChainhookDefinition_Validate demonstrates reporting every problem in a definition.
+func ExampleChainhookDefinition_Validate() {
+	definition := &ChainhookDefinition{
+		Name:    "broken-hook",
+		Version: DefaultAPIVersion,
+		Chain:   ChainStacks,
+		Network: NetworkMainnet,
+		Filters: NewChainhookFilters(
+			&FTEventFilter{Asset: "SP000000000000000000002Q6VF78.token::tkn", Action: "swap"},
+			&ContractLogFilter{ContractIdentifier: StringPtr("pool")},
+			&FTTransferFilter{Asset: "USDA", Sender: &Principal{}},
+		),
+		Action: ChainhookAction{Type: ActionTypeHTTPPost, URL: "ftp://example.com/webhook"},
+	}
+
+	var errs ValidationErrors
+	if errors.As(definition.Validate(), &errs) {
+		for _, err := range errs {
+			fmt.Println(err.Field)
+		}
+	}
+	// Output:
+	// action.url
+	// filters.events[0].action
+	// filters.events[1].contract_identifier
+	// filters.events[2].asset
+	// filters.events[2].sender
+}
+
+// ExampleChainhookDefinition_Validate_network demonstrates rejecting addresses from the wrong network.
+func ExampleChainhookDefinition_Validate_network() {
+	_, err := NewChainhookBuilder("testnet-hook", NetworkTestnet).
+		WithWebhookURL("https://example.com/webhook").
+		AddSTXTransfer(PrincipalStandard("SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7"), nil, nil).
+		Build()
+
+	fmt.Println(err)
+	// Output:
+	// AddSTXTransfer: validation error on field 'filters.events[0].sender.standard': "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7" is a mainnet address but the definition targets testnet
+}
+
+// ExampleChainhookBuilder_Build demonstrates builder errors naming the method that caused them.
+func ExampleChainhookBuilder_Build() {
+	_, err := NewChainhookBuilder("broken-hook", NetworkTestnet).
+		WithWebhookURL("").
+		AddFilter(nil).
+		AddFTTransfer("", nil, nil, nil).
+		AddSTXTransfer(PrincipalStandard("SP000000000000000000002Q6VF78"), nil, nil).
+		Build()
+
+	var errs BuilderErrors
+	if errors.As(err, &errs) {
+		for _, err := range errs {
+			fmt.Println(err)
+		}
+	}
+	// Output:
+	// WithWebhookURL: validation error on field 'action.url': webhook URL is required
+	// AddFilter: validation error on field 'filter': filter cannot be nil
+	// AddFTTransfer: validation error on field 'filter.asset': asset identifier is required
+	// AddSTXTransfer: validation error on field 'filters.events[0].sender.standard': "SP000000000000000000002Q6VF78" is a mainnet address but the definition targets testnet
+}
@@ -0,0 +1,237 @@
+package chainhooks
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ============================================================================
+// Definition Explanation
+// ============================================================================
+
+// Explain summarizes in plain English what a definition matches and what it
+// delivers, for example:
+//
+//	On mainnet, POST to https://example.com/webhook whenever SP...token::tkn is
+//	transferred from SP2J..., including decoded Clarity values; expires after
+//	100 occurrences.
+func Explain(def *ChainhookDefinition) string {
+	if def == nil {
+		return "No definition."
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "On %s, ", def.Network)
+	if def.Action.Type == ActionTypeHTTPPost {
+		fmt.Fprintf(&sb, "POST to %s", def.Action.URL)
+	} else {
+		fmt.Fprintf(&sb, "deliver with %q to %s", def.Action.Type, def.Action.URL)
+	}
+
+	var clauses []string
+	for _, filter := range def.Filters.Events {
+		if clause := explainFilter(filter); clause != "" {
+			clauses = append(clauses, clause)
+		}
+	}
+	if len(clauses) == 0 {
+		sb.WriteString(", but no filters are defined so nothing matches")
+	} else {
+		sb.WriteString(" whenever ")
+		sb.WriteString(strings.Join(clauses, " or "))
+	}
+
+	if opts := def.Options; opts != nil {
+		if included := explainIncludes(opts); len(included) > 0 {
+			sb.WriteString(", including ")
+			sb.WriteString(joinList(included, "and"))
+		}
+		if opts.EnableOnRegistration != nil {
+			if *opts.EnableOnRegistration {
+				sb.WriteString("; enabled on registration")
+			} else {
+				sb.WriteString("; disabled on registration")
+			}
+		}
+		if expiry := explainExpiry(opts); expiry != "" {
+			sb.WriteString("; expires after ")
+			sb.WriteString(expiry)
+		}
+	}
+
+	sb.WriteString(".")
+	return sb.String()
+}
+
+// explainFilter describes the events matched by a filter.
+func explainFilter(filter EventFilter) string {
+	switch f := filter.(type) {
+	case nil:
+		return ""
+	case *FTEventFilter:
+		return explainToken(string(f.Asset), f.Action, f.Sender, f.Receiver, f.Amount)
+	case *FTMintFilter:
+		return explainToken(string(f.Asset), FilterActionMint, nil, f.Recipient, f.Amount)
+	case *FTBurnFilter:
+		return explainToken(string(f.Asset), FilterActionBurn, f.Sender, nil, f.Amount)
+	case *FTTransferFilter:
+		return explainToken(string(f.Asset), FilterActionTransfer, f.Sender, f.Recipient, f.Amount)
+	case *NFTEventFilter:
+		return explainToken("an NFT of "+string(f.Asset), f.Action, f.Sender, f.Receiver, nil)
+	case *NFTMintFilter:
+		return explainToken("an NFT of "+string(f.Asset), FilterActionMint, nil, f.Recipient, nil)
+	case *NFTBurnFilter:
+		return explainToken("an NFT of "+string(f.Asset), FilterActionBurn, f.Sender, nil, nil)
+	case *NFTTransferFilter:
+		return explainToken("an NFT of "+string(f.Asset), FilterActionTransfer, f.Sender, f.Recipient, nil)
+	case *STXEventFilter:
+		return explainToken("STX", f.Action, f.Sender, f.Receiver, f.Amount)
+	case *STXMintFilter:
+		return explainToken("STX", FilterActionMint, nil, f.Recipient, f.Amount)
+	case *STXBurnFilter:
+		return explainToken("STX", FilterActionBurn, f.Sender, nil, f.Amount)
+	case *STXTransferFilter:
+		return explainToken("STX", FilterActionTransfer, f.Sender, f.Recipient, f.Amount)
+	case *ContractDeployFilter:
+		return "a contract is deployed" + explainPrincipal(" by ", f.DeployerPrincipal)
+	case *ContractCallFilter:
+		contract := "any contract"
+		if f.ContractIdentifier != nil {
+			contract = *f.ContractIdentifier
+		}
+		clause := contract + " is called"
+		if f.Method != nil {
+			clause = fmt.Sprintf("method %s of %s is called", *f.Method, contract)
+		}
+		return clause + explainPrincipal(" by ", f.Sender)
+	case *ContractLogFilter:
+		if f.ContractIdentifier != nil {
+			return *f.ContractIdentifier + " prints a log event"
+		}
+		return "any contract prints a log event"
+	case *BalanceChangeFilter:
+		if f.Principal != nil {
+			return "the balance of " + principalString(f.Principal) + " changes"
+		}
+		return "any balance changes"
+	case *CoinbaseFilter:
+		return "a coinbase reward is paid" + explainPrincipal(" to ", f.Recipient)
+	case *TenureChangeFilter:
+		return "a tenure changes"
+	case *RawEventFilter:
+		if _, known := eventFilterFactories[f.Type]; known {
+			return fmt.Sprintf("a %s event occurs (the filter has fields this client version does not understand, so its conditions are not described)", f.Type)
+		}
+		return fmt.Sprintf("a %s event occurs (an event type this client version does not understand, so its conditions are not described)", f.Type)
+	default:
+		return fmt.Sprintf("a %s event occurs", filter.EventType())
+	}
+}
+
+// tokenVerbs maps a filter action to its past participle.
+var tokenVerbs = map[string]string{
+	FilterActionMint:     "minted",
+	FilterActionBurn:     "burned",
+	FilterActionTransfer: "transferred",
+	FilterActionLock:     "locked",
+}
+
+// explainToken describes a token filter. An empty action matches every
+// action of the token.
+func explainToken(subject, action string, sender, recipient *Principal, amount *Amount) string {
+	verb, ok := tokenVerbs[action]
+	switch {
+	case action == "" && subject == "STX":
+		verb = "minted, burned, transferred or locked"
+	case action == "":
+		verb = "minted, burned or transferred"
+	case !ok:
+		verb = fmt.Sprintf("affected by %q", action)
+	}
+
+	clause := subject + " is " + verb
+	clause += explainPrincipal(" from ", sender)
+	clause += explainPrincipal(" to ", recipient)
+	if amount != nil {
+		if subject == "STX" {
+			clause += fmt.Sprintf(" with an amount of exactly %s STX", amount.STX())
+		} else {
+			clause += " with an amount of exactly " + amount.String()
+		}
+	}
+	return clause
+}
+
+// explainPrincipal returns prefix followed by the principal, or nothing if
+// the principal is not set.
+func explainPrincipal(prefix string, p *Principal) string {
+	if p == nil {
+		return ""
+	}
+	return prefix + principalString(p)
+}
+
+// principalString returns the address of a principal.
+func principalString(p *Principal) string {
+	switch {
+	case p.Standard != nil:
+		return *p.Standard
+	case p.Contract != nil:
+		return *p.Contract
+	default:
+		return "an unspecified principal"
+	}
+}
+
+// explainIncludes lists the optional payload contents that are enabled.
+func explainIncludes(opts *ChainhookOptions) []string {
+	var included []string
+	for _, option := range []struct {
+		value *bool
+		text  string
+	}{
+		{opts.DecodeClarityValues, "decoded Clarity values"},
+		{opts.IncludeContractABI, "contract ABIs"},
+		{opts.IncludeContractSourceCode, "contract source code"},
+		{opts.IncludePostConditions, "post conditions"},
+		{opts.IncludeRawTransactions, "raw transactions"},
+		{opts.IncludeBlockSignatures, "block signatures"},
+		{opts.IncludeBlockMetadata, "block metadata"},
+	} {
+		if option.value != nil && *option.value {
+			included = append(included, option.text)
+		}
+	}
+	return included
+}
+
+// explainExpiry describes the expiration options.
+func explainExpiry(opts *ChainhookOptions) string {
+	var limits []string
+	if opts.ExpireAfterOccurrences != nil {
+		limits = append(limits, pluralize(*opts.ExpireAfterOccurrences, "occurrence"))
+	}
+	if opts.ExpireAfterEvaluations != nil {
+		limits = append(limits, pluralize(*opts.ExpireAfterEvaluations, "evaluation"))
+	}
+	return joinList(limits, "or")
+}
+
+func pluralize(n uint64, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// joinList joins items as "a, b and c".
+func joinList(items []string, conjunction string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	default:
+		return strings.Join(items[:len(items)-1], ", ") + " " + conjunction + " " + items[len(items)-1]
+	}
+}
@@ -0,0 +1,82 @@
+package chainhooks
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExplainCoversEveryFilterType(t *testing.T) {
+	for eventType, factory := range eventFilterFactories {
+		clause := explainFilter(factory())
+		if clause == "" || strings.Contains(clause, string(eventType)+" event occurs") {
+			t.Errorf("%s filters have no explanation: %q", eventType, clause)
+		}
+	}
+}
+
+func TestExplain(t *testing.T) {
+	const addr = "SP000000000000000000002Q6VF78"
+
+	tests := []struct {
+		name string
+		def  *ChainhookDefinition
+		want string
+	}{
+		{
+			name: "no filters",
+			def:  &ChainhookDefinition{Network: NetworkTestnet, Action: ChainhookAction{Type: ActionTypeHTTPPost, URL: "https://example.com"}},
+			want: "On testnet, POST to https://example.com, but no filters are defined so nothing matches.",
+		},
+		{
+			name: "every option",
+			def: &ChainhookDefinition{
+				Network: NetworkMainnet,
+				Action:  ChainhookAction{Type: ActionTypeHTTPPost, URL: "https://example.com"},
+				Filters: NewChainhookFilters(
+					&ContractCallFilter{ContractIdentifier: StringPtr(addr + ".pool"), Method: StringPtr("swap"), Sender: PrincipalStandard(addr)},
+					&NFTEventFilter{Asset: addr + ".nft::punk"},
+					&STXEventFilter{Receiver: PrincipalContract(addr + ".vault")},
+				),
+				Options: &ChainhookOptions{
+					EnableOnRegistration:      BoolPtr(false),
+					ExpireAfterEvaluations:    Uint64Ptr(1),
+					ExpireAfterOccurrences:    Uint64Ptr(5),
+					DecodeClarityValues:       BoolPtr(true),
+					IncludeContractABI:        BoolPtr(true),
+					IncludeContractSourceCode: BoolPtr(true),
+					IncludePostConditions:     BoolPtr(true),
+					IncludeRawTransactions:    BoolPtr(true),
+					IncludeBlockSignatures:    BoolPtr(true),
+					IncludeBlockMetadata:      BoolPtr(false),
+				},
+			},
+			want: "On mainnet, POST to https://example.com whenever method swap of " + addr + ".pool is called by " + addr +
+				" or an NFT of " + addr + ".nft::punk is minted, burned or transferred" +
+				" or STX is minted, burned, transferred or locked to " + addr + ".vault" +
+				", including decoded Clarity values, contract ABIs, contract source code, post conditions, raw transactions and block signatures" +
+				"; disabled on registration; expires after 5 occurrences or 1 evaluation.",
+		},
+		{
+			name: "raw filters",
+			def: &ChainhookDefinition{
+				Network: NetworkMainnet,
+				Action:  ChainhookAction{Type: ActionTypeHTTPPost, URL: "https://example.com"},
+				Filters: NewChainhookFilters(
+					&RawEventFilter{Type: "future_event", Raw: []byte(`{"type":"future_event","weight":3}`)},
+					&RawEventFilter{Type: EventTypeCoinbase, Raw: []byte(`{"type":"coinbase","extra":true}`)},
+				),
+			},
+			want: "On mainnet, POST to https://example.com whenever" +
+				" a future_event event occurs (an event type this client version does not understand, so its conditions are not described)" +
+				" or a coinbase event occurs (the filter has fields this client version does not understand, so its conditions are not described).",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Explain(tt.def); got != tt.want {
+				t.Fatalf("got  %q\nwant %q", got, tt.want)
+			}
+		})
+	}
+}
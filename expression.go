@@ -0,0 +1,395 @@
+package chainhooks
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/tony1908/chainhooks-client-go/stacks"
+)
+
+// ============================================================================
+// Filter Expressions
+// ============================================================================
+
+// Filter expressions are a compact text syntax for event filters, intended
+// for configuration files and command-line flags:
+//
+//	stx_transfer(sender=SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7, amount=1000000)
+//	contract_call(contract=SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7.pool, method=swap-x-for-y) or ft_burn(asset=SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7.token::tkn)
+//
+// Each filter is written as its event type followed by its fields in
+// parentheses. Field names are the JSON field names, with "contract" and
+// "deployer" accepted for "contract_identifier" and "deployer_principal".
+// A principal containing a '.' must be a valid contract identifier and
+// becomes a contract principal; any other principal must be a valid Stacks
+// address. Asset identifiers, contract identifiers, actions and method
+// names are checked as Validate checks them. Any value may also be written
+// as a double-quoted Go string literal. Filters are combined with "or".
+
+// expressionAliases maps JSON field names to their shorter expression names.
+var expressionAliases = map[string]string{
+	"contract_identifier": "contract",
+	"deployer_principal":  "deployer",
+}
+
+var (
+	principalPtrType = reflect.TypeOf(&Principal{})
+	amountPtrType    = reflect.TypeOf(&Amount{})
+	stringPtrType    = reflect.TypeOf(new(string))
+)
+
+// FilterSyntaxError reports an invalid filter expression and the byte offset
+// in the input where the problem was found.
+type FilterSyntaxError struct {
+	Input   string
+	Offset  int
+	Message string
+}
+
+// Error implements the error interface.
+func (e *FilterSyntaxError) Error() string {
+	return fmt.Sprintf("filter expression: %s at offset %d", e.Message, e.Offset)
+}
+
+// ParseFilterExpression parses one or more filters separated by "or".
+func ParseFilterExpression(s string) ([]EventFilter, error) {
+	p := &expressionParser{src: s}
+	var filters []EventFilter
+	for {
+		filter, err := p.filter()
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+
+		p.skipSpace()
+		if p.pos == len(p.src) {
+			return filters, nil
+		}
+		start := p.pos
+		if word := p.ident(); word != "or" {
+			return nil, p.errorAt(start, "expected \"or\" or end of expression")
+		}
+	}
+}
+
+// ParseFilter parses an expression containing exactly one filter.
+func ParseFilter(s string) (EventFilter, error) {
+	p := &expressionParser{src: s}
+	filter, err := p.filter()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos != len(p.src) {
+		return nil, p.errorAt(p.pos, "expected end of expression")
+	}
+	return filter, nil
+}
+
+// FormatFilterExpression formats filters as an expression that
+// ParseFilterExpression parses back to the same filters.
+func FormatFilterExpression(filters []EventFilter) (string, error) {
+	parts := make([]string, len(filters))
+	for i, filter := range filters {
+		part, err := FormatFilter(filter)
+		if err != nil {
+			return "", fmt.Errorf("filter %d: %w", i, err)
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, " or "), nil
+}
+
+// FormatFilter formats a filter as an expression that ParseFilter parses
+// back to the same filter, except that the parsed filter always has its Type
+// field set. Unset fields are omitted. A Type field naming another event
+// type, and fields that ParseFilter would reject, such as a lower-case
+// address or a contract identifier given as a standard principal, are
+// reported as an error.
+func FormatFilter(filter EventFilter) (string, error) {
+	if filter == nil {
+		return "", fmt.Errorf("filter cannot be nil")
+	}
+	if _, known := eventFilterFactories[filter.EventType()]; !known {
+		return "", fmt.Errorf("unsupported event type %q", filter.EventType())
+	}
+	if _, raw := filter.(*RawEventFilter); raw {
+		return "", fmt.Errorf("cannot format undecoded %s filter", filter.EventType())
+	}
+
+	v := reflect.ValueOf(filter).Elem()
+	if typ := EventType(v.FieldByName("Type").String()); typ != "" && typ != filter.EventType() {
+		return "", fmt.Errorf("type: %q does not match %s filter", typ, filter.EventType())
+	}
+	var args []string
+	for _, field := range expressionFields(v.Type()) {
+		value := v.Field(field.index)
+		if value.IsZero() {
+			continue
+		}
+		if err := checkExpressionField(filter, field); err != nil {
+			return "", fmt.Errorf("%s: %w", field.name, err)
+		}
+
+		var text string
+		switch value.Type() {
+		case principalPtrType:
+			p := value.Interface().(*Principal)
+			if p.Standard != nil {
+				text = *p.Standard
+			} else {
+				text = *p.Contract
+			}
+		case amountPtrType:
+			text = value.Interface().(*Amount).String()
+		case stringPtrType:
+			text = value.Elem().String()
+		default:
+			text = value.String()
+		}
+		args = append(args, field.name+"="+formatExpressionValue(text))
+	}
+
+	return fmt.Sprintf("%s(%s)", filter.EventType(), strings.Join(args, ", ")), nil
+}
+
+// formatExpressionValue quotes a value if it cannot be written bare.
+func formatExpressionValue(s string) string {
+	if s == "" || strings.ContainsAny(s, " \t\r\n,()\"") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// expressionField is a filter struct field settable from an expression.
+type expressionField struct {
+	name  string
+	json  string
+	index int
+}
+
+// expressionFields lists the settable fields of a filter struct in
+// declaration order. Every field except "type" is settable.
+func expressionFields(t reflect.Type) []expressionField {
+	var fields []expressionField
+	for i := 0; i < t.NumField(); i++ {
+		tag, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if tag == "" || tag == "-" || tag == "type" {
+			continue
+		}
+		name := tag
+		if alias, ok := expressionAliases[tag]; ok {
+			name = alias
+		}
+		fields = append(fields, expressionField{name: name, json: tag, index: i})
+	}
+	return fields
+}
+
+// ============================================================================
+// Parser
+// ============================================================================
+
+type expressionParser struct {
+	src string
+	pos int
+}
+
+func (p *expressionParser) errorAt(offset int, format string, args ...interface{}) *FilterSyntaxError {
+	return &FilterSyntaxError{
+		Input:   p.src,
+		Offset:  offset,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func (p *expressionParser) skipSpace() {
+	for p.pos < len(p.src) && strings.IndexByte(" \t\r\n", p.src[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// ident reads a name made of letters, digits and underscores.
+func (p *expressionParser) ident() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c != '_' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			break
+		}
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+// expect consumes c or returns an error.
+func (p *expressionParser) expect(c byte) error {
+	p.skipSpace()
+	if p.pos == len(p.src) {
+		return p.errorAt(p.pos, "expected %q, found end of expression", c)
+	}
+	if p.src[p.pos] != c {
+		return p.errorAt(p.pos, "expected %q, found %q", c, p.src[p.pos])
+	}
+	p.pos++
+	return nil
+}
+
+// filter parses "type(key=value, ...)".
+func (p *expressionParser) filter() (EventFilter, error) {
+	p.skipSpace()
+	start := p.pos
+	name := p.ident()
+	if name == "" {
+		if p.pos == len(p.src) {
+			return nil, p.errorAt(start, "expected filter type, found end of expression")
+		}
+		return nil, p.errorAt(start, "expected filter type")
+	}
+	factory, ok := eventFilterFactories[EventType(name)]
+	if !ok {
+		return nil, p.errorAt(start, "unknown filter type %q", name)
+	}
+
+	filter := factory()
+	v := reflect.ValueOf(filter).Elem()
+	v.FieldByName("Type").Set(reflect.ValueOf(EventType(name)))
+	fields := make(map[string]expressionField)
+	for _, field := range expressionFields(v.Type()) {
+		fields[field.name] = field
+		fields[field.json] = field
+	}
+
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.src) && p.src[p.pos] == ')' {
+		p.pos++
+		return filter, nil
+	}
+
+	seen := make(map[int]bool)
+	for {
+		p.skipSpace()
+		keyStart := p.pos
+		key := p.ident()
+		if key == "" {
+			return nil, p.errorAt(keyStart, "expected field name")
+		}
+		field, ok := fields[key]
+		if !ok {
+			return nil, p.errorAt(keyStart, "unknown field %q for %s", key, name)
+		}
+		if seen[field.index] {
+			return nil, p.errorAt(keyStart, "duplicate field %q", key)
+		}
+		seen[field.index] = true
+
+		if err := p.expect('='); err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		valueStart := p.pos
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		if err := setExpressionField(v.Field(field.index), value); err != nil {
+			return nil, p.errorAt(valueStart, "invalid %s: %v", key, err)
+		}
+		if err := checkExpressionField(filter, field); err != nil {
+			return nil, p.errorAt(valueStart, "invalid %s: %v", key, err)
+		}
+
+		p.skipSpace()
+		if p.pos == len(p.src) {
+			return nil, p.errorAt(p.pos, "expected ',' or ')', found end of expression")
+		}
+		switch p.src[p.pos] {
+		case ',':
+			p.pos++
+		case ')':
+			p.pos++
+			return filter, nil
+		default:
+			return nil, p.errorAt(p.pos, "expected ',' or ')', found %q", p.src[p.pos])
+		}
+	}
+}
+
+// value reads a bare value or a quoted string.
+func (p *expressionParser) value() (string, error) {
+	start := p.pos
+	if p.pos < len(p.src) && p.src[p.pos] == '"' {
+		for i := p.pos + 1; i < len(p.src); i++ {
+			switch p.src[i] {
+			case '\\':
+				i++
+			case '"':
+				s, err := strconv.Unquote(p.src[start : i+1])
+				if err != nil {
+					return "", p.errorAt(start, "invalid quoted string")
+				}
+				p.pos = i + 1
+				return s, nil
+			}
+		}
+		return "", p.errorAt(start, "unterminated quoted string")
+	}
+
+	for p.pos < len(p.src) && strings.IndexByte(" \t\r\n,()\"", p.src[p.pos]) < 0 {
+		p.pos++
+	}
+	if p.pos == start {
+		return "", p.errorAt(start, "expected value")
+	}
+	return p.src[start:p.pos], nil
+}
+
+// setExpressionField sets a filter struct field from its text value.
+func setExpressionField(field reflect.Value, value string) error {
+	switch field.Type() {
+	case principalPtrType:
+		if strings.Contains(value, ".") {
+			if _, _, err := stacks.ParseContractIdentifier(value); err != nil {
+				return err
+			}
+			field.Set(reflect.ValueOf(PrincipalContract(value)))
+		} else {
+			if _, err := stacks.ParseAddress(value); err != nil {
+				return err
+			}
+			field.Set(reflect.ValueOf(PrincipalStandard(value)))
+		}
+	case amountPtrType:
+		amount, err := ParseAmount(value)
+		if err != nil {
+			return fmt.Errorf("amount %q must be an unsigned integer", value)
+		}
+		field.Set(reflect.ValueOf(&amount))
+	case stringPtrType:
+		field.Set(reflect.ValueOf(&value))
+	default:
+		field.SetString(value)
+	}
+	return nil
+}
+
+// checkExpressionField validates one field of filter as Validate would,
+// without regard to the network, and ignoring problems with other fields.
+func checkExpressionField(filter EventFilter, field expressionField) error {
+	v := &validator{}
+	v.filter("filter", filter)
+	path := "filter." + field.json
+	for _, err := range v.errs {
+		if err.Field == path || strings.HasPrefix(err.Field, path+".") {
+			return errors.New(err.Reason)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package chainhooks
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFilterExpressionRoundTrip(t *testing.T) {
+	const (
+		addr     = "SP000000000000000000002Q6VF78"
+		contract = addr + ".pool"
+		asset    = AssetIdentifier(addr + ".token::tkn")
+	)
+	amount := AmountPtr(MustParseAmount("340282366920938463463374607431768211455"))
+
+	filters := []EventFilter{
+		&FTEventFilter{Type: EventTypeFTEvent, Asset: asset, Action: FilterActionMint, Sender: PrincipalStandard(addr), Receiver: PrincipalContract(contract), Amount: amount},
+		&FTMintFilter{Type: EventTypeFTMint, Asset: asset, Recipient: PrincipalStandard(addr)},
+		&FTBurnFilter{Type: EventTypeFTBurn, Asset: asset, Amount: amount},
+		&FTTransferFilter{Type: EventTypeFTTransfer, Asset: asset, Sender: PrincipalStandard(addr), Recipient: PrincipalStandard(addr)},
+		&NFTEventFilter{Type: EventTypeNFTEvent, Asset: asset, Action: FilterActionBurn},
+		&NFTMintFilter{Type: EventTypeNFTMint, Asset: asset},
+		&NFTBurnFilter{Type: EventTypeNFTBurn, Asset: asset, Sender: PrincipalContract(contract)},
+		&NFTTransferFilter{Type: EventTypeNFTTransfer, Asset: asset, Recipient: PrincipalStandard(addr)},
+		&STXEventFilter{Type: EventTypeSTXEvent, Action: FilterActionLock, Amount: amount},
+		&STXMintFilter{Type: EventTypeSTXMint, Recipient: PrincipalStandard(addr)},
+		&STXBurnFilter{Type: EventTypeSTXBurn},
+		&STXTransferFilter{Type: EventTypeSTXTransfer, Sender: PrincipalStandard(addr), Amount: AmountPtr(NewAmount(1000000))},
+		&ContractDeployFilter{Type: EventTypeContractDeploy, DeployerPrincipal: PrincipalStandard(addr)},
+		&ContractCallFilter{Type: EventTypeContractCall, ContractIdentifier: StringPtr(contract), Method: StringPtr("swap-x-for-y"), Sender: PrincipalStandard(addr)},
+		&ContractCallFilter{Type: EventTypeContractCall, Method: StringPtr("is-valid?<=>")},
+		&ContractLogFilter{Type: EventTypeContractLog, ContractIdentifier: StringPtr(contract)},
+		&BalanceChangeFilter{Type: EventTypeBalanceChange, Principal: PrincipalContract(contract)},
+		&CoinbaseFilter{Type: EventTypeCoinbase},
+		&TenureChangeFilter{Type: EventTypeTenureChange},
+	}
+
+	for _, filter := range filters {
+		text, err := FormatFilter(filter)
+		if err != nil {
+			t.Fatalf("FormatFilter(%#v): %v", filter, err)
+		}
+		parsed, err := ParseFilter(text)
+		if err != nil {
+			t.Fatalf("ParseFilter(%q): %v", text, err)
+		}
+		if !reflect.DeepEqual(parsed, filter) {
+			t.Fatalf("round trip of %q: got %#v, want %#v", text, parsed, filter)
+		}
+	}
+
+	text, err := FormatFilterExpression(filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := ParseFilterExpression(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(parsed, filters) {
+		t.Fatalf("round trip of expression %q failed", text)
+	}
+
+	// An unset Type is filled in by ParseFilter; a mismatched one is rejected
+	text, err = FormatFilter(&STXBurnFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	untyped, err := ParseFilter(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(untyped, &STXBurnFilter{Type: EventTypeSTXBurn}) {
+		t.Fatalf("unexpected filter %#v", untyped)
+	}
+	if _, err := FormatFilter(&STXBurnFilter{Type: EventTypeSTXMint}); err == nil {
+		t.Fatal("expected error for mismatched type")
+	}
+
+	// Quoted values parse like bare ones
+	quoted, err := ParseFilter(`contract_call(method="swap-x-for-y")`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&ContractCallFilter{Type: EventTypeContractCall, Method: StringPtr("swap-x-for-y")}); !reflect.DeepEqual(quoted, want) {
+		t.Fatalf("got %#v", quoted)
+	}
+
+	// Values that would not parse back are not formatted
+	invalid := []EventFilter{
+		&STXTransferFilter{Sender: PrincipalStandard(strings.ToLower(addr))},
+		&STXTransferFilter{Sender: PrincipalStandard(contract)},
+		&BalanceChangeFilter{Principal: &Principal{Standard: StringPtr(addr), Contract: StringPtr(contract)}},
+		&FTBurnFilter{Asset: "garbage"},
+		&ContractLogFilter{ContractIdentifier: StringPtr("garbage")},
+		&NFTEventFilter{Asset: asset, Action: FilterActionLock},
+		&ContractCallFilter{Method: StringPtr("bad name")},
+	}
+	for _, filter := range invalid {
+		if text, err := FormatFilter(filter); err == nil {
+			t.Errorf("FormatFilter(%#v) = %q, expected an error", filter, text)
+		}
+	}
+}
+
+func TestParseFilterExpressionErrors(t *testing.T) {
+	tests := []struct {
+		input  string
+		offset int
+	}{
+		{"", 0},
+		{"stx_transfr()", 0},
+		{"stx_transfer", 12},
+		{"stx_transfer(sender)", 19},
+		{"stx_transfer(amount=-5)", 20},
+		{"stx_transfer(asset=x)", 13},
+		{"stx_transfer(amount=1, amount=2)", 23},
+		{"stx_transfer(amount=1", 21},
+		{"stx_transfer(amount=1 sender=x)", 22},
+		{"coinbase() and tenure_change()", 11},
+		{"coinbase() or", 13},
+		{`contract_call(method="unterminated)`, 21},
+		{"stx_transfer(sender=x)", 20},
+		{"stx_transfer(sender=sp000000000000000000002q6vf78)", 20},
+		{"stx_transfer(sender=SP000000000000000000002Q6VF7O)", 20},
+		{"stx_transfer(sender=SP000000000000000000002Q6VF78.)", 20},
+		{"balance_change(principal=x.pool)", 25},
+		{"ft_burn(asset=garbage)", 14},
+		{"ft_burn(asset=SP000000000000000000002Q6VF78.token::2tkn)", 14},
+		{"nft_mint(asset=SP000000000000000000002Q6VF78.2token::tkn)", 15},
+		{"contract_call(contract=garbage)", 23},
+		{"contract_log(contract=SP000000000000000000002Q6VF78.2pool)", 22},
+		{"ft_event(action=swap)", 16},
+		{"nft_event(action=lock)", 17},
+		{`contract_call(method="bad name")`, 21},
+		{"contract_call(method=2swap)", 21},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseFilterExpression(tt.input)
+			var syntaxErr *FilterSyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("expected FilterSyntaxError, got %v", err)
+			}
+			if syntaxErr.Offset != tt.offset {
+				t.Fatalf("got offset %d (%v), want %d", syntaxErr.Offset, err, tt.offset)
+			}
+		})
+	}
+}
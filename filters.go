@@ -0,0 +1,423 @@
+package chainhooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ============================================================================
+// Polymorphic Filter Decoding
+// ============================================================================
+
+// eventFilterFactories maps each event type to a constructor for its filter struct.
+var eventFilterFactories = map[EventType]func() EventFilter{
+	EventTypeFTEvent:        func() EventFilter { return &FTEventFilter{} },
+	EventTypeFTMint:         func() EventFilter { return &FTMintFilter{} },
+	EventTypeFTBurn:         func() EventFilter { return &FTBurnFilter{} },
+	EventTypeFTTransfer:     func() EventFilter { return &FTTransferFilter{} },
+	EventTypeNFTEvent:       func() EventFilter { return &NFTEventFilter{} },
+	EventTypeNFTMint:        func() EventFilter { return &NFTMintFilter{} },
+	EventTypeNFTBurn:        func() EventFilter { return &NFTBurnFilter{} },
+	EventTypeNFTTransfer:    func() EventFilter { return &NFTTransferFilter{} },
+	EventTypeSTXEvent:       func() EventFilter { return &STXEventFilter{} },
+	EventTypeSTXMint:        func() EventFilter { return &STXMintFilter{} },
+	EventTypeSTXBurn:        func() EventFilter { return &STXBurnFilter{} },
+	EventTypeSTXTransfer:    func() EventFilter { return &STXTransferFilter{} },
+	EventTypeContractDeploy: func() EventFilter { return &ContractDeployFilter{} },
+	EventTypeContractCall:   func() EventFilter { return &ContractCallFilter{} },
+	EventTypeContractLog:    func() EventFilter { return &ContractLogFilter{} },
+	EventTypeBalanceChange:  func() EventFilter { return &BalanceChangeFilter{} },
+	EventTypeCoinbase:       func() EventFilter { return &CoinbaseFilter{} },
+	EventTypeTenureChange:   func() EventFilter { return &TenureChangeFilter{} },
+}
+
+// RawEventFilter holds a filter that could not be decoded into one of the
+// concrete filter structs, either because its type is unknown to this
+// library or because it carries fields the struct does not have. It is
+// marshaled back exactly as it was received, but ChainhookDefinition.Validate
+// rejects it, so a definition holding one cannot be registered or updated.
+type RawEventFilter struct {
+	Type EventType
+	Raw  json.RawMessage
+}
+
+func (f *RawEventFilter) eventFilterMarker() {}
+
+// MarshalJSON returns the filter exactly as it was received.
+func (f *RawEventFilter) MarshalJSON() ([]byte, error) {
+	if len(f.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return f.Raw, nil
+}
+
+// DecodeEventFilter decodes a single JSON filter into the concrete filter
+// struct selected by its "type" field. Filters of unknown type, or with
+// fields the struct cannot represent, are returned as a *RawEventFilter so
+// that no information is lost. A filter of known type whose fields hold
+// invalid values, such as a fractional amount, is reported as an
+// *EventFilterDecodeError.
+func DecodeEventFilter(data []byte) (EventFilter, error) {
+	var header struct {
+		Type EventType `json:"type"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("failed to decode event filter: %w", err)
+	}
+
+	raw := &RawEventFilter{
+		Type: header.Type,
+		Raw:  append(json.RawMessage(nil), data...),
+	}
+
+	factory, ok := eventFilterFactories[header.Type]
+	if !ok {
+		return raw, nil
+	}
+
+	filter := factory()
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(filter); err != nil {
+		fields := filterFieldTypes(filter)
+		var values map[string]json.RawMessage
+		if json.Unmarshal(data, &values) != nil {
+			return nil, &EventFilterDecodeError{Index: -1, Type: header.Type, Err: err}
+		}
+		for key := range values {
+			if _, known := fields[key]; !known {
+				return raw, nil
+			}
+		}
+		return nil, locateFilterDecodeError(header.Type, fields, values, err)
+	}
+
+	return filter, nil
+}
+
+// filterFieldTypes maps the JSON field names of a filter struct to their types.
+func filterFieldTypes(filter EventFilter) map[string]reflect.Type {
+	t := reflect.TypeOf(filter).Elem()
+	fields := make(map[string]reflect.Type, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields[name] = t.Field(i).Type
+		}
+	}
+	return fields
+}
+
+// locateFilterDecodeError finds the field whose value failed to decode, so
+// that the error names it. err is returned as is if no single field fails.
+func locateFilterDecodeError(eventType EventType, fields map[string]reflect.Type, values map[string]json.RawMessage, err error) error {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fieldErr := json.Unmarshal(values[key], reflect.New(fields[key]).Interface())
+		if fieldErr == nil {
+			continue
+		}
+		field := key
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(fieldErr, &typeErr) && typeErr.Field != "" {
+			field += "." + typeErr.Field
+		}
+		return &EventFilterDecodeError{Index: -1, Type: eventType, Field: field, Err: fieldErr}
+	}
+	return &EventFilterDecodeError{Index: -1, Type: eventType, Err: err}
+}
+
+// UnmarshalJSON decodes each filter into its concrete EventFilter struct.
+// The received encoding of each filter is kept and marshaled again as long
+// as the filter is not modified.
+func (f *ChainhookFilters) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Events []json.RawMessage `json:"events"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	f.received = nil
+	if raw.Events == nil {
+		f.Events = nil
+		return nil
+	}
+
+	f.Events = make([]EventFilter, 0, len(raw.Events))
+	for i, event := range raw.Events {
+		filter, err := DecodeEventFilter(event)
+		if err != nil {
+			var decodeErr *EventFilterDecodeError
+			if errors.As(err, &decodeErr) {
+				decodeErr.Index = i
+				return decodeErr
+			}
+			return fmt.Errorf("filters.events[%d]: %w", i, err)
+		}
+		f.Events = append(f.Events, filter)
+	}
+	f.received = make([][]byte, len(raw.Events))
+	for i, event := range raw.Events {
+		f.received[i] = event
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the filters. A filter that was decoded by
+// UnmarshalJSON and still encodes as it did then is marshaled with its
+// received encoding, so that definitions read from the server are sent
+// back with their original key order and formatting.
+func (f ChainhookFilters) MarshalJSON() ([]byte, error) {
+	var out struct {
+		Events []json.RawMessage `json:"events"`
+	}
+	if f.Events != nil {
+		out.Events = make([]json.RawMessage, len(f.Events))
+	}
+	for i, filter := range f.Events {
+		data, err := json.Marshal(filter)
+		if err != nil {
+			return nil, err
+		}
+		if i < len(f.received) && encodesAs(f.received[i], data) {
+			data = f.received[i]
+		}
+		out.Events[i] = data
+	}
+	return json.Marshal(&out)
+}
+
+// encodesAs reports whether the filter received as received still marshals
+// to data.
+func encodesAs(received, data []byte) bool {
+	filter, err := DecodeEventFilter(received)
+	if err != nil {
+		return false
+	}
+	original, err := json.Marshal(filter)
+	return err == nil && bytes.Equal(original, data)
+}
+
+// ============================================================================
+// Filter Marshaling
+// ============================================================================
+
+// Each filter struct marshals with its own event type, ignoring its Type field.
+
+// MarshalJSON implements json.Marshaler.
+func (f *FTEventFilter) MarshalJSON() ([]byte, error) {
+	type plain FTEventFilter
+	filter := plain(*f)
+	filter.Type = EventTypeFTEvent
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *FTMintFilter) MarshalJSON() ([]byte, error) {
+	type plain FTMintFilter
+	filter := plain(*f)
+	filter.Type = EventTypeFTMint
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *FTBurnFilter) MarshalJSON() ([]byte, error) {
+	type plain FTBurnFilter
+	filter := plain(*f)
+	filter.Type = EventTypeFTBurn
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *FTTransferFilter) MarshalJSON() ([]byte, error) {
+	type plain FTTransferFilter
+	filter := plain(*f)
+	filter.Type = EventTypeFTTransfer
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *NFTEventFilter) MarshalJSON() ([]byte, error) {
+	type plain NFTEventFilter
+	filter := plain(*f)
+	filter.Type = EventTypeNFTEvent
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *NFTMintFilter) MarshalJSON() ([]byte, error) {
+	type plain NFTMintFilter
+	filter := plain(*f)
+	filter.Type = EventTypeNFTMint
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *NFTBurnFilter) MarshalJSON() ([]byte, error) {
+	type plain NFTBurnFilter
+	filter := plain(*f)
+	filter.Type = EventTypeNFTBurn
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *NFTTransferFilter) MarshalJSON() ([]byte, error) {
+	type plain NFTTransferFilter
+	filter := plain(*f)
+	filter.Type = EventTypeNFTTransfer
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *STXEventFilter) MarshalJSON() ([]byte, error) {
+	type plain STXEventFilter
+	filter := plain(*f)
+	filter.Type = EventTypeSTXEvent
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *STXMintFilter) MarshalJSON() ([]byte, error) {
+	type plain STXMintFilter
+	filter := plain(*f)
+	filter.Type = EventTypeSTXMint
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *STXBurnFilter) MarshalJSON() ([]byte, error) {
+	type plain STXBurnFilter
+	filter := plain(*f)
+	filter.Type = EventTypeSTXBurn
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *STXTransferFilter) MarshalJSON() ([]byte, error) {
+	type plain STXTransferFilter
+	filter := plain(*f)
+	filter.Type = EventTypeSTXTransfer
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *ContractDeployFilter) MarshalJSON() ([]byte, error) {
+	type plain ContractDeployFilter
+	filter := plain(*f)
+	filter.Type = EventTypeContractDeploy
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *ContractCallFilter) MarshalJSON() ([]byte, error) {
+	type plain ContractCallFilter
+	filter := plain(*f)
+	filter.Type = EventTypeContractCall
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *ContractLogFilter) MarshalJSON() ([]byte, error) {
+	type plain ContractLogFilter
+	filter := plain(*f)
+	filter.Type = EventTypeContractLog
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *BalanceChangeFilter) MarshalJSON() ([]byte, error) {
+	type plain BalanceChangeFilter
+	filter := plain(*f)
+	filter.Type = EventTypeBalanceChange
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *CoinbaseFilter) MarshalJSON() ([]byte, error) {
+	type plain CoinbaseFilter
+	filter := plain(*f)
+	filter.Type = EventTypeCoinbase
+	return json.Marshal(&filter)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (f *TenureChangeFilter) MarshalJSON() ([]byte, error) {
+	type plain TenureChangeFilter
+	filter := plain(*f)
+	filter.Type = EventTypeTenureChange
+	return json.Marshal(&filter)
+}
+
+// EventType implements EventFilter.
+func (f *RawEventFilter) EventType() EventType {
+	return f.Type
+}
+
+// ============================================================================
+// Filter Helpers
+// ============================================================================
+
+// NewChainhookFilters creates a ChainhookFilters holding the given filters.
+func NewChainhookFilters(filters ...EventFilter) ChainhookFilters {
+	return ChainhookFilters{
+		Events: filters,
+	}
+}
+
+// EventFiltersFromInterfaces converts a filter list written for the former
+// []interface{} type of ChainhookFilters.Events. Filter structs stored by
+// value, such as STXTransferFilter{}, are converted to pointers.
+//
+// Deprecated: build []EventFilter directly or use NewChainhookFilters. This
+// helper only exists to ease migrating existing code.
+func EventFiltersFromInterfaces(events []interface{}) ([]EventFilter, error) {
+	filters := make([]EventFilter, 0, len(events))
+	for i, event := range events {
+		if filter, ok := event.(EventFilter); ok && filter != nil {
+			filters = append(filters, filter)
+			continue
+		}
+
+		v := reflect.ValueOf(event)
+		if v.IsValid() && v.Kind() == reflect.Struct {
+			ptr := reflect.New(v.Type())
+			ptr.Elem().Set(v)
+			if filter, ok := ptr.Interface().(EventFilter); ok {
+				filters = append(filters, filter)
+				continue
+			}
+		}
+
+		return nil, &ValidationError{
+			Field:  fmt.Sprintf("filters.events[%d]", i),
+			Reason: fmt.Sprintf("%T is not an event filter", event),
+		}
+	}
+	return filters, nil
+}
+
+// definitionEventTypes returns the event types of every filter in def.
+func definitionEventTypes(def *ChainhookDefinition) []EventType {
+	if def == nil {
+		return nil
+	}
+	types := make([]EventType, 0, len(def.Filters.Events))
+	for _, filter := range def.Filters.Events {
+		if filter != nil {
+			types = append(types, filter.EventType())
+		}
+	}
+	return types
+}
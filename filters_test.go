@@ -0,0 +1,176 @@
+package chainhooks
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestChainhookFiltersRoundTrip(t *testing.T) {
+	definition, err := NewChainhookBuilder("round-trip", NetworkMainnet).
+		WithWebhookURL("https://example.com/webhook").
+		AddFTTransfer("SP000000000000000000002Q6VF78.token::tkn", PrincipalStandard("SP000000000000000000002Q6VF78"), nil, AmountPtr(NewAmount(100))).
+		AddNFTMint("SP000000000000000000002Q6VF78.nft::punk", nil).
+		AddSTXTransfer(nil, nil, nil).
+		AddContractCall(StringPtr("SP000000000000000000002Q6VF78.pool"), StringPtr("swap"), nil).
+		AddTenureChange().
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := json.Marshal(definition)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded ChainhookDefinition
+	if err := json.Unmarshal(first, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	// The received encodings are only kept for marshaling
+	decoded.Filters.received = nil
+	if !reflect.DeepEqual(definition, &decoded) {
+		t.Fatalf("decoded definition differs:\n got %#v\nwant %#v", decoded.Filters.Events, definition.Filters.Events)
+	}
+
+	second, err := json.Marshal(&decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(first) != string(second) {
+		t.Fatalf("round trip changed bytes:\n got %s\nwant %s", second, first)
+	}
+}
+
+func TestChainhookFiltersKeepsUnknownFiltersRaw(t *testing.T) {
+	input := `{"events":[{"type":"future_event","weight":3},{"type":"stx_mint","extra":true},{"type":"coinbase"}]}`
+
+	var filters ChainhookFilters
+	if err := json.Unmarshal([]byte(input), &filters); err != nil {
+		t.Fatal(err)
+	}
+
+	if raw, ok := filters.Events[0].(*RawEventFilter); !ok || raw.Type != "future_event" {
+		t.Fatalf("expected raw filter for unknown type, got %#v", filters.Events[0])
+	}
+	if _, ok := filters.Events[1].(*RawEventFilter); !ok {
+		t.Fatalf("expected raw filter for unknown field, got %#v", filters.Events[1])
+	}
+	if _, ok := filters.Events[2].(*CoinbaseFilter); !ok {
+		t.Fatalf("expected *CoinbaseFilter, got %#v", filters.Events[2])
+	}
+
+	output, err := json.Marshal(&filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(output) != input {
+		t.Fatalf("round trip changed bytes:\n got %s\nwant %s", output, input)
+	}
+}
+
+func TestChainhookFiltersKeepsReceivedEncoding(t *testing.T) {
+	input := `{"events":[{"asset":"SP000000000000000000002Q6VF78.token::tkn","type":"ft_event","amount":5},{"type":"stx_transfer","amount":"1000","sender":{"standard":"SP000000000000000000002Q6VF78"}}]}`
+
+	var filters ChainhookFilters
+	if err := json.Unmarshal([]byte(input), &filters); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := filters.Events[0].(*FTEventFilter); !ok {
+		t.Fatalf("expected *FTEventFilter, got %#v", filters.Events[0])
+	}
+
+	output, err := json.Marshal(&filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(output) != input {
+		t.Fatalf("round trip changed bytes:\n got %s\nwant %s", output, input)
+	}
+
+	// A modified filter is encoded again; the others keep their encoding
+	filters.Events[1].(*STXTransferFilter).Amount = AmountPtr(NewAmount(2000))
+	output, err = json.Marshal(filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"events":[{"asset":"SP000000000000000000002Q6VF78.token::tkn","type":"ft_event","amount":5},{"type":"stx_transfer","sender":{"standard":"SP000000000000000000002Q6VF78"},"amount":"2000"}]}`
+	if string(output) != want {
+		t.Fatalf("unexpected encoding after modification:\n got %s\nwant %s", output, want)
+	}
+}
+
+func TestDecodeEventFilterInvalidKnownType(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		index int
+		field string
+		msg   string
+	}{
+		{"fractional amount", `{"type":"stx_transfer","amount":"1.5"}`, 0, "amount", "filters.events[0].amount: invalid stx_transfer filter"},
+		{"wrong type", `{"type":"ft_mint","asset":"SP000000000000000000002Q6VF78.token::tkn","recipient":{"standard":1}}`, 0, "recipient.standard", "filters.events[0].recipient.standard"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var filters ChainhookFilters
+			err := json.Unmarshal([]byte(`{"events":[`+tt.input+`]}`), &filters)
+			var decodeErr *EventFilterDecodeError
+			if !errors.As(err, &decodeErr) {
+				t.Fatalf("expected EventFilterDecodeError, got %v", err)
+			}
+			if decodeErr.Index != tt.index || decodeErr.Field != tt.field {
+				t.Fatalf("got index %d field %q, want %d %q", decodeErr.Index, decodeErr.Field, tt.index, tt.field)
+			}
+			if !strings.HasPrefix(err.Error(), tt.msg) {
+				t.Fatalf("unexpected message %q", err)
+			}
+
+			if _, err := DecodeEventFilter([]byte(tt.input)); !errors.As(err, &decodeErr) || decodeErr.Index != -1 {
+				t.Fatalf("expected EventFilterDecodeError without index, got %v", err)
+			}
+		})
+	}
+
+	// Unknown fields still keep the filter raw, whatever the other values
+	filter, err := DecodeEventFilter([]byte(`{"type":"stx_transfer","amount":"1.5","extra":true}`))
+	if _, ok := filter.(*RawEventFilter); err != nil || !ok {
+		t.Fatalf("expected raw filter, got %#v, %v", filter, err)
+	}
+}
+
+func TestFilterMarshalsItsOwnType(t *testing.T) {
+	filters := NewChainhookFilters(
+		&FTMintFilter{Type: EventTypeNFTMint, Asset: "SP000000000000000000002Q6VF78.token::tkn"},
+		&STXTransferFilter{},
+	)
+
+	output, err := json.Marshal(filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"events":[{"type":"ft_mint","asset":"SP000000000000000000002Q6VF78.token::tkn"},{"type":"stx_transfer"}]}`
+	if string(output) != want {
+		t.Fatalf("got %s, want %s", output, want)
+	}
+}
+
+func TestEventFiltersFromInterfaces(t *testing.T) {
+	filters, err := EventFiltersFromInterfaces([]interface{}{
+		STXTransferFilter{Amount: AmountPtr(NewAmount(10))},
+		&CoinbaseFilter{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, ok := filters[0].(*STXTransferFilter); !ok || *f.Amount != NewAmount(10) {
+		t.Fatalf("expected value filter to be converted to a pointer, got %#v", filters[0])
+	}
+
+	if _, err := EventFiltersFromInterfaces([]interface{}{"stx_transfer"}); err == nil {
+		t.Fatal("expected an error for a non-filter value")
+	}
+}
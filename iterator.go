@@ -0,0 +1,139 @@
+package chainhooks
+
+import (
+	"context"
+)
+
+// ============================================================================
+// Chainhook Iterator
+// ============================================================================
+
+// PageFetcher fetches one page of the chainhook listing, such as
+// Client.GetChainhooks.
+type PageFetcher func(ctx context.Context, opts *PaginationOptions) (*PaginatedChainhookResponse, error)
+
+// ChainhookIterator walks every chainhook in the account, fetching pages
+// lazily as it advances.
+//
+// The listing may change while it is being walked. Hooks that shift into a
+// later page are returned only once; hooks deleted mid-walk may shift the
+// remaining hooks so that one of them is not returned.
+//
+// Use it as a cursor:
+//
+//	it := client.AllChainhooks(ctx, 50)
+//	for it.Next() {
+//		hook := it.Chainhook()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type ChainhookIterator struct {
+	ctx      context.Context
+	fetch    PageFetcher
+	pageSize uint64
+
+	offset  uint64
+	total   uint64
+	started bool
+	page    []Chainhook
+	index   int
+	current *Chainhook
+	seen    map[UUID]struct{}
+	done    bool
+	err     error
+}
+
+// AllChainhooks returns an iterator over every chainhook in the account,
+// fetching pageSize hooks per request. A pageSize of zero uses
+// DefaultPageSize.
+func (c *Client) AllChainhooks(ctx context.Context, pageSize uint64) *ChainhookIterator {
+	return NewChainhookIterator(ctx, c.GetChainhooks, pageSize)
+}
+
+// NewChainhookIterator creates an iterator that lists pages of pageSize
+// hooks with fetch. A pageSize of zero uses DefaultPageSize. It lets fakes
+// of ChainhooksAPI build an iterator over a listing of their own.
+func NewChainhookIterator(ctx context.Context, fetch PageFetcher, pageSize uint64) *ChainhookIterator {
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+	return &ChainhookIterator{
+		ctx:      ctx,
+		fetch:    fetch,
+		pageSize: pageSize,
+		seen:     make(map[UUID]struct{}),
+	}
+}
+
+// Next advances the iterator to the next chainhook. It returns false when
+// the listing is exhausted, the context is done, or a request fails; check
+// Err to distinguish these cases.
+func (it *ChainhookIterator) Next() bool {
+	for {
+		if it.done {
+			return false
+		}
+
+		if err := it.ctx.Err(); err != nil {
+			it.fail(err)
+			return false
+		}
+
+		for it.index < len(it.page) {
+			hook := &it.page[it.index]
+			it.index++
+			if _, dup := it.seen[hook.UUID]; dup {
+				continue
+			}
+			it.seen[hook.UUID] = struct{}{}
+			it.current = hook
+			return true
+		}
+
+		if it.started && (len(it.page) == 0 || it.offset >= it.total) {
+			it.done = true
+			it.current = nil
+			return false
+		}
+
+		if err := it.fetchPage(); err != nil {
+			it.fail(err)
+			return false
+		}
+	}
+}
+
+// Chainhook returns the chainhook at the current position. It is only valid
+// after a call to Next that returned true.
+func (it *ChainhookIterator) Chainhook() *Chainhook {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *ChainhookIterator) Err() error {
+	return it.err
+}
+
+// fetchPage loads the page at the current offset.
+func (it *ChainhookIterator) fetchPage() error {
+	resp, err := it.fetch(it.ctx, NewPaginationOptions(it.offset, it.pageSize))
+	if err != nil {
+		return err
+	}
+
+	it.started = true
+	it.page = resp.Chainhooks
+	it.index = 0
+	it.total = resp.Total
+	it.offset += uint64(len(resp.Chainhooks))
+	return nil
+}
+
+// fail stops the iteration with err.
+func (it *ChainhookIterator) fail(err error) {
+	it.err = err
+	it.done = true
+	it.current = nil
+}
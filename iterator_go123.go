@@ -0,0 +1,32 @@
+//go:build go1.23
+
+package chainhooks
+
+import (
+	"context"
+	"iter"
+)
+
+// AllChainhooksSeq returns a range-over-func sequence over every chainhook in
+// the account. Each range over the sequence starts a fresh listing. If the
+// listing fails, the final pair yielded carries a nil chainhook and the error.
+//
+//	for hook, err := range client.AllChainhooksSeq(ctx, 50) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func (c *Client) AllChainhooksSeq(ctx context.Context, pageSize uint64) iter.Seq2[*Chainhook, error] {
+	return func(yield func(*Chainhook, error) bool) {
+		it := c.AllChainhooks(ctx, pageSize)
+		for it.Next() {
+			if !yield(it.Chainhook(), nil) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			yield(nil, err)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+//go:build go1.23
+
+package chainhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// newShiftingListingClient serves pages of listing. onFetch is called after each
+// page is built and may modify the listing or fail the request.
+func newShiftingListingClient(t *testing.T, listing []UUID, onFetch func(fetch int, listing *[]UUID) bool) *Client {
+	t.Helper()
+	var mu sync.Mutex
+	fetches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		offset, _ := strconv.ParseUint(r.URL.Query().Get("offset"), 10, 64)
+		limit, _ := strconv.ParseUint(r.URL.Query().Get("limit"), 10, 64)
+		resp := PaginatedChainhookResponse{Total: uint64(len(listing)), Offset: offset, Limit: limit}
+		for i := offset; i < offset+limit && i < uint64(len(listing)); i++ {
+			resp.Chainhooks = append(resp.Chainhooks, Chainhook{UUID: listing[i]})
+		}
+		fetches++
+		if onFetch != nil && !onFetch(fetches, &listing) {
+			http.Error(w, `{"error":"unavailable"}`, http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+	return NewClient(server.URL)
+}
+
+func TestAllChainhooksSeq(t *testing.T) {
+	tests := []struct {
+		name    string
+		onFetch func(fetch int, listing *[]UUID) bool
+		stopAt  int
+		want    []UUID
+		wantErr bool
+	}{
+		{
+			name: "all pages",
+			want: []UUID{"a", "b", "c", "d", "e"},
+		},
+		{
+			name: "dedupe across page shifts",
+			onFetch: func(fetch int, listing *[]UUID) bool {
+				if fetch == 1 {
+					// A new hook listed first pushes "b" into the second page
+					*listing = append([]UUID{"new"}, *listing...)
+				}
+				return true
+			},
+			want: []UUID{"a", "b", "c", "d", "e"},
+		},
+		{
+			name:   "early break",
+			stopAt: 3,
+			want:   []UUID{"a", "b", "c"},
+		},
+		{
+			name:    "error",
+			onFetch: func(fetch int, _ *[]UUID) bool { return fetch < 2 },
+			want:    []UUID{"a", "b"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newShiftingListingClient(t, []UUID{"a", "b", "c", "d", "e"}, tt.onFetch)
+
+			var got []UUID
+			var errs []error
+			for hook, err := range client.AllChainhooksSeq(context.Background(), 2) {
+				if err != nil {
+					if hook != nil {
+						t.Errorf("error yielded with hook %v", hook.UUID)
+					}
+					errs = append(errs, err)
+					continue
+				}
+				got = append(got, hook.UUID)
+				if len(got) == tt.stopAt {
+					break
+				}
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if !tt.wantErr && len(errs) != 0 {
+				t.Fatalf("unexpected errors %v", errs)
+			}
+			if tt.wantErr {
+				var httpErr *HttpError
+				if len(errs) != 1 || !errors.As(errs[0], &httpErr) {
+					t.Fatalf("expected a single HttpError, got %v", errs)
+				}
+			}
+		})
+	}
+}
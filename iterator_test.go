@@ -0,0 +1,80 @@
+package chainhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// listingFetcher serves pages from a listing that can be modified between requests.
+func listingFetcher(listing *[]UUID, onFetch func()) PageFetcher {
+	return func(ctx context.Context, opts *PaginationOptions) (*PaginatedChainhookResponse, error) {
+		resp := &PaginatedChainhookResponse{
+			Total:  uint64(len(*listing)),
+			Offset: opts.Offset,
+			Limit:  opts.Limit,
+		}
+		for i := opts.Offset; i < opts.Offset+opts.Limit && i < uint64(len(*listing)); i++ {
+			resp.Chainhooks = append(resp.Chainhooks, Chainhook{UUID: (*listing)[i]})
+		}
+		if onFetch != nil {
+			onFetch()
+		}
+		return resp, nil
+	}
+}
+
+func TestChainhookIteratorDeduplicatesShiftedHooks(t *testing.T) {
+	listing := []UUID{"a", "b", "c", "d", "e"}
+	fetches := 0
+	fetch := listingFetcher(&listing, func() {
+		fetches++
+		if fetches == 1 {
+			// A new hook listed first pushes "b" into the second page
+			listing = append([]UUID{"new"}, listing...)
+		}
+	})
+
+	it := NewChainhookIterator(context.Background(), fetch, 2)
+	var got []UUID
+	for it.Next() {
+		got = append(got, it.Chainhook().UUID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []UUID{"a", "b", "c", "d", "e"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestChainhookIteratorStopsOnContextCancellation(t *testing.T) {
+	listing := []UUID{"a", "b", "c", "d"}
+	ctx, cancel := context.WithCancel(context.Background())
+	it := NewChainhookIterator(ctx, listingFetcher(&listing, nil), 2)
+
+	if !it.Next() {
+		t.Fatalf("expected a first hook, got error %v", it.Err())
+	}
+	cancel()
+	if it.Next() {
+		t.Fatal("expected iteration to stop after cancellation")
+	}
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", it.Err())
+	}
+}
+
+func TestChainhookIteratorEmptyListing(t *testing.T) {
+	var listing []UUID
+	it := NewChainhookIterator(context.Background(), listingFetcher(&listing, nil), 0)
+	if it.Next() {
+		t.Fatal("expected no hooks")
+	}
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+}
@@ -0,0 +1,357 @@
+package chainhooks
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ============================================================================
+// Definition Linter
+// ============================================================================
+
+// LintSeverity is the severity of a lint finding.
+type LintSeverity string
+
+const (
+	// LintError marks a filter that cannot work as written.
+	LintError LintSeverity = "error"
+	// LintWarning marks a likely mistake, such as duplicate deliveries.
+	LintWarning LintSeverity = "warning"
+)
+
+// Lint rules.
+const (
+	LintRuleDuplicateFilter   = "duplicate-filter"
+	LintRuleSubsumedFilter    = "subsumed-filter"
+	LintRuleContradictory     = "contradictory-filter"
+	LintRuleNeverMatches      = "never-matches"
+	LintRuleBroadHeavyOptions = "broad-filter-heavy-options"
+)
+
+// LintFinding is a problem found by Lint.
+type LintFinding struct {
+	Severity LintSeverity
+	// Rule identifies the check that produced the finding, such as
+	// LintRuleSubsumedFilter.
+	Rule string
+	// Path is the JSON path of the offending filter or option, such as
+	// "filters.events[2]".
+	Path       string
+	Message    string
+	Suggestion string
+}
+
+// String formats the finding on a single line.
+func (f LintFinding) String() string {
+	return fmt.Sprintf("%s: %s: %s (%s)", f.Severity, f.Path, f.Message, f.Suggestion)
+}
+
+// Lint reports filters in a definition that overlap, contradict themselves or
+// can never match, and broad filters combined with options that make every
+// delivery large. It does not repeat the checks of Validate; lint a
+// definition that validates.
+func Lint(def *ChainhookDefinition) []LintFinding {
+	if def == nil {
+		return nil
+	}
+
+	l := &linter{}
+	filters := make([]*lintFilter, len(def.Filters.Events))
+	for i, filter := range def.Filters.Events {
+		filters[i] = newLintFilter(i, filter)
+		before := len(l.findings)
+		l.filter(filters[i])
+		// A filter that cannot match is not worth comparing with others.
+		if len(l.findings) > before {
+			filters[i].category = ""
+		}
+	}
+	l.overlaps(filters)
+	l.options(def.Options, filters)
+	return l.findings
+}
+
+type linter struct {
+	findings []LintFinding
+}
+
+func (l *linter) add(severity LintSeverity, rule, path, suggestion, format string, args ...interface{}) {
+	l.findings = append(l.findings, LintFinding{
+		Severity:   severity,
+		Rule:       rule,
+		Path:       path,
+		Message:    fmt.Sprintf(format, args...),
+		Suggestion: suggestion,
+	})
+}
+
+// filter checks a single filter for conditions that can never hold.
+func (l *linter) filter(f *lintFilter) {
+	if f.category == "" {
+		// Nil filters are reported by Validate, and filters of a known type
+		// that could not be decoded cannot be analyzed.
+		if _, known := eventFilterFactories[f.eventType]; f.eventType != "" && !known {
+			l.add(LintError, LintRuleNeverMatches, f.path(), "Remove the filter or use a supported event type.",
+				"%q is not a supported event type, so the filter never matches", f.eventType)
+		}
+		return
+	}
+
+	sender, hasSender := f.constraints["sender"]
+	recipient, hasRecipient := f.constraints["recipient"]
+
+	if hasSender && !f.actions[FilterActionTransfer] && !f.actions[FilterActionBurn] && !f.actions[FilterActionLock] && f.isToken() {
+		l.add(LintError, LintRuleContradictory, f.path()+".sender", "Remove the sender or change the action.",
+			"%s events have no sender", strings.Join(f.actionList(), "/"))
+	}
+	if hasRecipient && !f.actions[FilterActionTransfer] && !f.actions[FilterActionMint] && f.isToken() {
+		l.add(LintError, LintRuleContradictory, f.path()+"."+f.recipientField, "Remove the recipient or change the action.",
+			"%s events have no recipient", strings.Join(f.actionList(), "/"))
+	}
+	if hasSender && hasRecipient && sender == recipient && f.isToken() {
+		l.add(LintError, LintRuleNeverMatches, f.path(), "Use different sender and recipient principals.",
+			"transfers from a principal to itself are rejected by Clarity, so the filter never matches")
+	}
+	if amount, ok := f.constraints["amount"]; ok && amount == "0" {
+		l.add(LintError, LintRuleNeverMatches, f.path()+".amount", "Remove the amount or set it above zero.",
+			"token operations with an amount of zero are rejected by Clarity, so the filter never matches")
+	}
+}
+
+// overlaps reports duplicate filters and filters matched by another filter.
+func (l *linter) overlaps(filters []*lintFilter) {
+	for j, b := range filters {
+		if b.category == "" {
+			continue
+		}
+		for i, a := range filters {
+			if i == j || a.category == "" || !a.subsumes(b) {
+				continue
+			}
+			if b.subsumes(a) {
+				// Equivalent filters: report only the later one.
+				if i < j {
+					l.add(LintWarning, LintRuleDuplicateFilter, b.path(), fmt.Sprintf("Remove %s.", b.path()),
+						"%s filter matches the same events as %s, so every event is delivered twice", b.eventType, a.path())
+					break
+				}
+				continue
+			}
+			l.add(LintWarning, LintRuleSubsumedFilter, b.path(), fmt.Sprintf("Remove %s, or narrow %s.", b.path(), a.path()),
+				"%s filter is already covered by the %s filter at %s, so matching events are delivered twice", b.eventType, a.eventType, a.path())
+			break
+		}
+	}
+}
+
+// options reports heavy options combined with broad filters.
+func (l *linter) options(opts *ChainhookOptions, filters []*lintFilter) {
+	if opts == nil {
+		return
+	}
+
+	for _, f := range filters {
+		if f.eventType != EventTypeContractDeploy || len(f.constraints) > 0 {
+			continue
+		}
+		if opts.IncludeContractSourceCode != nil && *opts.IncludeContractSourceCode {
+			l.add(LintWarning, LintRuleBroadHeavyOptions, "options.include_contract_source_code",
+				fmt.Sprintf("Set deployer_principal on %s, or disable include_contract_source_code.", f.path()),
+				"source code is included for every contract deployed on the network because %s has no deployer", f.path())
+		}
+		if opts.IncludeContractABI != nil && *opts.IncludeContractABI {
+			l.add(LintWarning, LintRuleBroadHeavyOptions, "options.include_contract_abi",
+				fmt.Sprintf("Set deployer_principal on %s, or disable include_contract_abi.", f.path()),
+				"ABIs are included for every contract deployed on the network because %s has no deployer", f.path())
+		}
+	}
+
+	if opts.IncludeRawTransactions != nil && *opts.IncludeRawTransactions {
+		for _, f := range filters {
+			if f.category != "" && f.broad() {
+				l.add(LintWarning, LintRuleBroadHeavyOptions, "options.include_raw_transactions",
+					fmt.Sprintf("Narrow %s, or disable include_raw_transactions.", f.path()),
+					"raw transactions are included for every %s event because %s has no constraints", f.eventType, f.path())
+			}
+		}
+	}
+}
+
+// ============================================================================
+// Normalized Filters
+// ============================================================================
+
+// lintFilter is a filter reduced to the events it matches: a category of
+// events, the actions within the category and the fields constrained.
+type lintFilter struct {
+	index     int
+	eventType EventType
+	category  string
+	// actions is the set of matched actions, or the event type for
+	// categories without actions.
+	actions map[string]bool
+	// constraints maps a role such as "sender" to its normalized value.
+	constraints map[string]string
+	// recipientField is the JSON name of the recipient role's field.
+	recipientField string
+}
+
+var (
+	tokenActions = []string{FilterActionMint, FilterActionBurn, FilterActionTransfer}
+	stxActions   = []string{FilterActionMint, FilterActionBurn, FilterActionTransfer, FilterActionLock}
+)
+
+func newLintFilter(index int, filter EventFilter) *lintFilter {
+	f := &lintFilter{index: index, constraints: make(map[string]string), recipientField: "recipient"}
+	if filter == nil {
+		return f
+	}
+	f.eventType = filter.EventType()
+
+	switch v := filter.(type) {
+	case *FTEventFilter:
+		f.token("ft", v.Action, tokenActions, v.Asset, v.Sender, v.Receiver, v.Amount)
+		f.recipientField = "receiver"
+	case *FTMintFilter:
+		f.token("ft", FilterActionMint, tokenActions, v.Asset, nil, v.Recipient, v.Amount)
+	case *FTBurnFilter:
+		f.token("ft", FilterActionBurn, tokenActions, v.Asset, v.Sender, nil, v.Amount)
+	case *FTTransferFilter:
+		f.token("ft", FilterActionTransfer, tokenActions, v.Asset, v.Sender, v.Recipient, v.Amount)
+	case *NFTEventFilter:
+		f.token("nft", v.Action, tokenActions, v.Asset, v.Sender, v.Receiver, nil)
+		f.recipientField = "receiver"
+	case *NFTMintFilter:
+		f.token("nft", FilterActionMint, tokenActions, v.Asset, nil, v.Recipient, nil)
+	case *NFTBurnFilter:
+		f.token("nft", FilterActionBurn, tokenActions, v.Asset, v.Sender, nil, nil)
+	case *NFTTransferFilter:
+		f.token("nft", FilterActionTransfer, tokenActions, v.Asset, v.Sender, v.Recipient, nil)
+	case *STXEventFilter:
+		f.token("stx", v.Action, stxActions, "", v.Sender, v.Receiver, v.Amount)
+		f.recipientField = "receiver"
+	case *STXMintFilter:
+		f.token("stx", FilterActionMint, stxActions, "", nil, v.Recipient, v.Amount)
+	case *STXBurnFilter:
+		f.token("stx", FilterActionBurn, stxActions, "", v.Sender, nil, v.Amount)
+	case *STXTransferFilter:
+		f.token("stx", FilterActionTransfer, stxActions, "", v.Sender, v.Recipient, v.Amount)
+	case *ContractDeployFilter:
+		f.single(v.DeployerPrincipal, "deployer")
+	case *ContractCallFilter:
+		f.single(nil, "")
+		f.setString("contract", v.ContractIdentifier, normalizeContractIdentifier)
+		f.setString("method", v.Method, nil)
+		f.setPrincipal("sender", v.Sender)
+	case *ContractLogFilter:
+		f.single(nil, "")
+		f.setString("contract", v.ContractIdentifier, normalizeContractIdentifier)
+	case *BalanceChangeFilter:
+		f.single(v.Principal, "principal")
+	case *CoinbaseFilter:
+		f.single(v.Recipient, "recipient")
+	case *TenureChangeFilter:
+		f.single(nil, "")
+	}
+	return f
+}
+
+// token fills in a token filter. An empty action matches every action.
+func (f *lintFilter) token(category, action string, all []string, asset AssetIdentifier, sender, recipient *Principal, amount *Amount) {
+	f.category = category
+	f.actions = make(map[string]bool)
+	if action == "" {
+		for _, a := range all {
+			f.actions[a] = true
+		}
+	} else {
+		f.actions[action] = true
+	}
+	if asset != "" {
+		contract, name, _ := strings.Cut(string(asset), assetSeparator)
+		f.constraints["asset"] = normalizeContractIdentifier(contract) + assetSeparator + name
+	}
+	f.setPrincipal("sender", sender)
+	f.setPrincipal("recipient", recipient)
+	if amount != nil {
+		f.constraints["amount"] = amount.String()
+	}
+}
+
+// single fills in a filter whose category is its event type, with an
+// optional principal constraint.
+func (f *lintFilter) single(p *Principal, role string) {
+	f.category = string(f.eventType)
+	f.actions = map[string]bool{string(f.eventType): true}
+	if p != nil {
+		f.setPrincipal(role, p)
+	}
+}
+
+func (f *lintFilter) setPrincipal(role string, p *Principal) {
+	switch {
+	case p == nil:
+	case p.Standard != nil:
+		f.constraints[role] = normalizeAddress(*p.Standard)
+	case p.Contract != nil:
+		f.constraints[role] = normalizeContractIdentifier(*p.Contract)
+	}
+}
+
+func (f *lintFilter) setString(role string, s *string, normalize func(string) string) {
+	if s == nil {
+		return
+	}
+	if normalize != nil {
+		f.constraints[role] = normalize(*s)
+	} else {
+		f.constraints[role] = *s
+	}
+}
+
+func (f *lintFilter) path() string {
+	return fmt.Sprintf("filters.events[%d]", f.index)
+}
+
+func (f *lintFilter) isToken() bool {
+	return f.category == "ft" || f.category == "nft" || f.category == "stx"
+}
+
+// broad reports whether the filter matches every event of its category.
+func (f *lintFilter) broad() bool {
+	return len(f.constraints) == 0
+}
+
+func (f *lintFilter) actionList() []string {
+	var actions []string
+	for _, a := range stxActions {
+		if f.actions[a] {
+			actions = append(actions, a)
+		}
+	}
+	return actions
+}
+
+// subsumes reports whether every event matched by b is also matched by f:
+// both cover the same category, f matches all of b's actions, and every
+// constraint of f is also a constraint of b with the same value.
+//
+// Amounts are exact matches, like every other constraint: a filter with an
+// amount only subsumes filters with the same amount, and a filter without an
+// amount covers every amount.
+func (f *lintFilter) subsumes(b *lintFilter) bool {
+	if f.category != b.category {
+		return false
+	}
+	for action := range b.actions {
+		if !f.actions[action] {
+			return false
+		}
+	}
+	for role, value := range f.constraints {
+		if b.constraints[role] != value {
+			return false
+		}
+	}
+	return true
+}
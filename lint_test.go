@@ -0,0 +1,103 @@
+package chainhooks
+
+import "testing"
+
+func TestLint(t *testing.T) {
+	const (
+		addr  = "SP000000000000000000002Q6VF78"
+		other = "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7"
+		asset = AssetIdentifier(addr + ".token::tkn")
+	)
+
+	tests := []struct {
+		name    string
+		filters []EventFilter
+		options *ChainhookOptions
+		want    []string // rule and path of each finding
+	}{
+		{
+			name:    "distinct filters",
+			filters: []EventFilter{&FTTransferFilter{Asset: asset}, &NFTTransferFilter{Asset: asset}, &STXTransferFilter{Sender: PrincipalStandard(addr)}, &STXTransferFilter{Sender: PrincipalStandard(other)}},
+		},
+		{
+			name:    "duplicate with different spelling",
+			filters: []EventFilter{&STXTransferFilter{Sender: PrincipalStandard(addr)}, &STXTransferFilter{Sender: PrincipalStandard("sp000000000000000000002q6vf78")}},
+			want:    []string{"duplicate-filter filters.events[1]"},
+		},
+		{
+			name:    "equivalent event and specific filters",
+			filters: []EventFilter{&FTEventFilter{Asset: asset, Action: FilterActionBurn}, &FTBurnFilter{Asset: asset}},
+			want:    []string{"duplicate-filter filters.events[1]"},
+		},
+		{
+			name:    "subsumed filter before broader one",
+			filters: []EventFilter{&NFTMintFilter{Asset: asset}, &NFTEventFilter{Asset: asset}},
+			want:    []string{"subsumed-filter filters.events[0]"},
+		},
+		{
+			name:    "constrained broad filter does not subsume",
+			filters: []EventFilter{&STXEventFilter{Sender: PrincipalStandard(addr)}, &STXMintFilter{}},
+		},
+		{
+			name:    "different exact amounts",
+			filters: []EventFilter{&STXTransferFilter{Amount: AmountPtr(NewAmount(100))}, &STXTransferFilter{Amount: AmountPtr(NewAmount(200))}},
+		},
+		{
+			name:    "amount covered by filter without amount",
+			filters: []EventFilter{&FTTransferFilter{Asset: asset, Amount: AmountPtr(NewAmount(100))}, &FTTransferFilter{Asset: asset}},
+			want:    []string{"subsumed-filter filters.events[0]"},
+		},
+		{
+			name:    "zero amount",
+			filters: []EventFilter{&STXTransferFilter{Amount: AmountPtr(NewAmount(0))}},
+			want:    []string{"never-matches filters.events[0].amount"},
+		},
+		{
+			name:    "mint with sender",
+			filters: []EventFilter{&STXEventFilter{Action: FilterActionMint, Sender: PrincipalStandard(addr)}},
+			want:    []string{"contradictory-filter filters.events[0].sender"},
+		},
+		{
+			name:    "burn with recipient",
+			filters: []EventFilter{&NFTEventFilter{Asset: asset, Action: FilterActionBurn, Receiver: PrincipalStandard(addr)}},
+			want:    []string{"contradictory-filter filters.events[0].receiver"},
+		},
+		{
+			name:    "unknown event type",
+			filters: []EventFilter{&RawEventFilter{Type: "future_event", Raw: []byte(`{"type":"future_event"}`)}},
+			want:    []string{"never-matches filters.events[0]"},
+		},
+		{
+			name:    "raw transactions on broad filter",
+			filters: []EventFilter{&CoinbaseFilter{}, &ContractCallFilter{ContractIdentifier: StringPtr(addr + ".pool")}},
+			options: &ChainhookOptions{IncludeRawTransactions: BoolPtr(true), IncludeContractSourceCode: BoolPtr(true)},
+			want:    []string{"broad-filter-heavy-options options.include_raw_transactions"},
+		},
+		{
+			name:    "ABI on narrow deploy filter",
+			filters: []EventFilter{&ContractDeployFilter{DeployerPrincipal: PrincipalStandard(addr)}},
+			options: &ChainhookOptions{IncludeContractABI: BoolPtr(true)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings := Lint(&ChainhookDefinition{Filters: NewChainhookFilters(tt.filters...), Options: tt.options})
+			var got []string
+			for _, f := range findings {
+				got = append(got, f.Rule+" "+f.Path)
+				if f.Suggestion == "" || f.Message == "" {
+					t.Errorf("finding %s has no message or suggestion", f.Rule)
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("got %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+package chainhooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// ============================================================================
+// Definition Versions
+// ============================================================================
+
+// DetectDefinitionVersion returns the "version" of an encoded definition. A
+// definition without a version is treated as DefaultAPIVersion, and numeric
+// versions such as 1 are returned in their string form.
+func DetectDefinitionVersion(data []byte) (string, error) {
+	var header struct {
+		Version json.RawMessage `json:"version"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return "", fmt.Errorf("failed to decode definition: %w", err)
+	}
+	return definitionVersion(header.Version)
+}
+
+// definitionVersion converts a raw "version" value to a string.
+func definitionVersion(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return DefaultAPIVersion, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		if s == "" {
+			return DefaultAPIVersion, nil
+		}
+		return s, nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err == nil {
+		return n.String(), nil
+	}
+	return "", fmt.Errorf("definition version must be a string or number, got %s", raw)
+}
+
+// ============================================================================
+// Migrations
+// ============================================================================
+
+// MigrationFunc converts an encoded definition, decoded as a generic JSON
+// object, from one version to the next in place. It returns the changes
+// that lost information, such as fields without an equivalent in the target
+// version. The registry sets the "version" field after the function returns.
+type MigrationFunc func(doc map[string]interface{}) ([]LossyChange, error)
+
+// LossyChange describes information lost by a migration step.
+type LossyChange struct {
+	// From and To are the versions of the migration step.
+	From string
+	To   string
+	// Path is the JSON path of the affected field, such as
+	// "filters.events[2].amount".
+	Path   string
+	Reason string
+}
+
+// String formats the change on a single line.
+func (c LossyChange) String() string {
+	return fmt.Sprintf("%s -> %s: %s: %s", c.From, c.To, c.Path, c.Reason)
+}
+
+// MigrationReport describes the steps taken by a migration.
+type MigrationReport struct {
+	From string
+	To   string
+	// Steps lists the versions visited, starting with From and ending with
+	// To. It holds a single version when no migration was needed.
+	Steps []string
+	// Lossy lists every change that lost information.
+	Lossy []LossyChange
+}
+
+// IsLossy reports whether any step lost information.
+func (r *MigrationReport) IsLossy() bool {
+	return len(r.Lossy) > 0
+}
+
+// MigrationRegistry holds migration functions between definition versions.
+// It is safe for concurrent use.
+type MigrationRegistry struct {
+	mu         sync.RWMutex
+	migrations map[string]map[string]MigrationFunc
+}
+
+// NewMigrationRegistry creates an empty registry.
+func NewMigrationRegistry() *MigrationRegistry {
+	return &MigrationRegistry{migrations: make(map[string]map[string]MigrationFunc)}
+}
+
+// DefaultMigrationRegistry is the registry used by MigrateDefinition and
+// DecodeDefinition. Definition version "1" is the only version so far, so it
+// starts empty.
+var DefaultMigrationRegistry = NewMigrationRegistry()
+
+// RegisterMigration registers a migration in DefaultMigrationRegistry.
+func RegisterMigration(from, to string, fn MigrationFunc) error {
+	return DefaultMigrationRegistry.Register(from, to, fn)
+}
+
+// Register adds a migration from one version to another. Migrations can be
+// registered in both directions, and a migration between distant versions
+// is found by chaining registered steps.
+func (r *MigrationRegistry) Register(from, to string, fn MigrationFunc) error {
+	if from == "" || to == "" || from == to {
+		return &ConfigError{Message: fmt.Sprintf("invalid migration from %q to %q", from, to)}
+	}
+	if fn == nil {
+		return &ConfigError{Message: fmt.Sprintf("migration from %q to %q has no function", from, to)}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.migrations[from][to]; exists {
+		return &ConfigError{Message: fmt.Sprintf("migration from %q to %q is already registered", from, to)}
+	}
+	if r.migrations[from] == nil {
+		r.migrations[from] = make(map[string]MigrationFunc)
+	}
+	r.migrations[from][to] = fn
+	return nil
+}
+
+// path returns the shortest chain of versions from one version to another.
+func (r *MigrationRegistry) path(from, to string) ([]string, error) {
+	if from == to {
+		return []string{from}, nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	previous := map[string]string{from: ""}
+	queue := []string{from}
+	for len(queue) > 0 {
+		version := queue[0]
+		queue = queue[1:]
+		for next := range r.migrations[version] {
+			if _, seen := previous[next]; seen {
+				continue
+			}
+			previous[next] = version
+			if next == to {
+				steps := []string{to}
+				for v := version; v != ""; v = previous[v] {
+					steps = append([]string{v}, steps...)
+				}
+				return steps, nil
+			}
+			queue = append(queue, next)
+		}
+	}
+	return nil, fmt.Errorf("no migration path from definition version %q to %q", from, to)
+}
+
+// Migrate converts an encoded definition to targetVersion and returns the
+// migrated encoding with a report of the steps taken. The "version" field of
+// the result is always set, as a string.
+func (r *MigrationRegistry) Migrate(data []byte, targetVersion string) ([]byte, *MigrationReport, error) {
+	value, err := decodeJSONValue(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode definition: %w", err)
+	}
+	doc, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("failed to decode definition: expected a JSON object")
+	}
+	rawVersion, _ := json.Marshal(doc["version"])
+	from, err := definitionVersion(rawVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	steps, err := r.path(from, targetVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+	report := &MigrationReport{From: from, To: targetVersion, Steps: steps}
+	if len(steps) == 1 {
+		if version, _ := doc["version"].(string); version == from {
+			return data, report, nil
+		}
+		// A missing, empty or numeric version is rewritten as the string
+		// ChainhookDefinition holds
+		doc["version"] = from
+		normalized, err := json.Marshal(doc)
+		if err != nil {
+			return nil, nil, err
+		}
+		return normalized, report, nil
+	}
+
+	for i := 0; i+1 < len(steps); i++ {
+		stepFrom, stepTo := steps[i], steps[i+1]
+		r.mu.RLock()
+		fn := r.migrations[stepFrom][stepTo]
+		r.mu.RUnlock()
+
+		lossy, err := fn(doc)
+		if err != nil {
+			return nil, nil, fmt.Errorf("migrating definition from version %q to %q: %w", stepFrom, stepTo, err)
+		}
+		for _, change := range lossy {
+			change.From, change.To = stepFrom, stepTo
+			report.Lossy = append(report.Lossy, change)
+		}
+		doc["version"] = stepTo
+	}
+
+	migrated, err := json.Marshal(doc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return migrated, report, nil
+}
+
+// MigrateDefinition converts a definition to targetVersion and reports the
+// steps taken and any information lost. def is not modified.
+//
+// The migrated definition is decoded strictly: a field that
+// ChainhookDefinition cannot hold is an error rather than silently dropped,
+// so migration functions must remove such fields and report a LossyChange.
+func (r *MigrationRegistry) MigrateDefinition(def *ChainhookDefinition, targetVersion string) (*ChainhookDefinition, *MigrationReport, error) {
+	if def == nil {
+		return nil, nil, &ValidationError{Field: "definition", Reason: "definition cannot be nil"}
+	}
+	data, err := json.Marshal(def)
+	if err != nil {
+		return nil, nil, err
+	}
+	migrated, report, err := r.Migrate(data, targetVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+	result, err := decodeDefinitionStrict(migrated)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode migrated definition: %w", err)
+	}
+	return result, report, nil
+}
+
+// DecodeDefinition decodes an encoded definition of any registered version,
+// migrating it to DefaultAPIVersion first if needed. As with
+// MigrateDefinition, fields that ChainhookDefinition cannot hold are an
+// error.
+func (r *MigrationRegistry) DecodeDefinition(data []byte) (*ChainhookDefinition, *MigrationReport, error) {
+	migrated, report, err := r.Migrate(data, DefaultAPIVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+	def, err := decodeDefinitionStrict(migrated)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode definition: %w", err)
+	}
+	return def, report, nil
+}
+
+// decodeDefinitionStrict decodes a definition, failing on fields that
+// ChainhookDefinition cannot hold rather than dropping them. Filters with
+// unknown fields are kept as a *RawEventFilter, so they lose nothing.
+func decodeDefinitionStrict(data []byte) (*ChainhookDefinition, error) {
+	var probe struct {
+		Filters map[string]json.RawMessage `json:"filters"`
+	}
+	if err := json.Unmarshal(data, &probe); err == nil {
+		for key := range probe.Filters {
+			if key != "events" {
+				return nil, fmt.Errorf("json: unknown field %q in filters", key)
+			}
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var def ChainhookDefinition
+	if err := decoder.Decode(&def); err != nil {
+		return nil, err
+	}
+	return &def, nil
+}
+
+// MigrateDefinition converts a definition to targetVersion using
+// DefaultMigrationRegistry.
+func MigrateDefinition(def *ChainhookDefinition, targetVersion string) (*ChainhookDefinition, *MigrationReport, error) {
+	return DefaultMigrationRegistry.MigrateDefinition(def, targetVersion)
+}
+
+// DecodeDefinition decodes an encoded definition of any version known to
+// DefaultMigrationRegistry, migrating it to DefaultAPIVersion.
+func DecodeDefinition(data []byte) (*ChainhookDefinition, *MigrationReport, error) {
+	return DefaultMigrationRegistry.DecodeDefinition(data)
+}
@@ -0,0 +1,226 @@
+package chainhooks
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// testMigrations returns a registry with hypothetical versions: "0" kept the
+// webhook URL at the top level and allowed a free-form comment, and "2"
+// caps the definition at one filter.
+func testMigrations(t *testing.T) *MigrationRegistry {
+	t.Helper()
+	registry := NewMigrationRegistry()
+	must := func(err error) {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	must(registry.Register("0", "1", func(doc map[string]interface{}) ([]LossyChange, error) {
+		url, ok := doc["webhook_url"].(string)
+		if !ok {
+			return nil, fmt.Errorf("webhook_url is required")
+		}
+		delete(doc, "webhook_url")
+		doc["action"] = map[string]interface{}{"type": ActionTypeHTTPPost, "url": url}
+
+		var lossy []LossyChange
+		if _, ok := doc["comment"]; ok {
+			delete(doc, "comment")
+			lossy = append(lossy, LossyChange{Path: "comment", Reason: "comments are not supported"})
+		}
+		return lossy, nil
+	}))
+	must(registry.Register("1", "2", func(doc map[string]interface{}) ([]LossyChange, error) {
+		filters, _ := doc["filters"].(map[string]interface{})
+		events, _ := filters["events"].([]interface{})
+		var lossy []LossyChange
+		for i := 1; i < len(events); i++ {
+			lossy = append(lossy, LossyChange{Path: fmt.Sprintf("filters.events[%d]", i), Reason: "only one filter is supported"})
+		}
+		if len(events) > 1 {
+			filters["events"] = events[:1]
+		}
+		return lossy, nil
+	}))
+	must(registry.Register("2", "1", func(doc map[string]interface{}) ([]LossyChange, error) {
+		return nil, nil
+	}))
+	return registry
+}
+
+func TestDetectDefinitionVersion(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{`{"name":"hook"}`, DefaultAPIVersion},
+		{`{"version":null}`, DefaultAPIVersion},
+		{`{"version":""}`, DefaultAPIVersion},
+		{`{"version":"2"}`, "2"},
+		{`{"version":3}`, "3"},
+	}
+	for _, tt := range tests {
+		got, err := DetectDefinitionVersion([]byte(tt.input))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.input, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	if _, err := DetectDefinitionVersion([]byte(`{"version":true}`)); err == nil {
+		t.Error("expected error for boolean version")
+	}
+	if _, err := DetectDefinitionVersion([]byte(`not json`)); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
+
+func TestMigrationRegistryDecodeDefinition(t *testing.T) {
+	registry := testMigrations(t)
+	legacy := []byte(`{
+		"name": "my-hook",
+		"version": "0",
+		"chain": "stacks",
+		"network": "mainnet",
+		"webhook_url": "https://example.com/webhook",
+		"comment": "watch the sender",
+		"filters": {"events": [
+			{"type": "stx_transfer", "sender": {"standard": "` + testAddress + `"}},
+			{"type": "contract_deploy"}
+		]}
+	}`)
+
+	got, report, err := registry.DecodeDefinition(legacy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := DiffDefinitions(testDefinition(), got); !diff.Empty() {
+		t.Fatalf("unexpected definition:\n%s", diff)
+	}
+	if !reflect.DeepEqual(report.Steps, []string{"0", "1"}) {
+		t.Errorf("unexpected steps %v", report.Steps)
+	}
+	want := []LossyChange{{From: "0", To: "1", Path: "comment", Reason: "comments are not supported"}}
+	if !reflect.DeepEqual(report.Lossy, want) {
+		t.Errorf("unexpected lossy changes %v", report.Lossy)
+	}
+
+	// A current definition decodes without migration.
+	got, report, err = registry.DecodeDefinition([]byte(`{"name":"my-hook","chain":"stacks","network":"mainnet"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Version != DefaultAPIVersion || report.IsLossy() || len(report.Steps) != 1 {
+		t.Errorf("unexpected result %+v, report %+v", got, report)
+	}
+
+	// A numeric version is decoded as its string form.
+	got, report, err = registry.DecodeDefinition([]byte(`{"name":"my-hook","version":1,"chain":"stacks","network":"mainnet"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Version != "1" || len(report.Steps) != 1 {
+		t.Errorf("unexpected result %+v, report %+v", got, report)
+	}
+
+	if _, _, err := registry.DecodeDefinition([]byte(`{"version":"0"}`)); err == nil {
+		t.Error("expected migration function error")
+	}
+	if _, _, err := registry.DecodeDefinition([]byte(`{"version":"9"}`)); err == nil {
+		t.Error("expected error for unknown version")
+	}
+
+	// Fields the definition cannot hold are not dropped silently.
+	for _, input := range []string{
+		`{"name":"my-hook","priority":1}`,
+		`{"name":"my-hook","action":{"type":"http_post","url":"https://example.com","retries":3}}`,
+		`{"name":"my-hook","filters":{"events":[],"mode":"any"}}`,
+		`{"name":"my-hook","version":"0","webhook_url":"https://example.com","tags":["a"]}`,
+	} {
+		if _, _, err := registry.DecodeDefinition([]byte(input)); err == nil {
+			t.Errorf("%s: expected error for unknown field", input)
+		}
+	}
+}
+
+func TestMigrationRegistryMigrateDefinition(t *testing.T) {
+	registry := testMigrations(t)
+	def := testDefinition()
+
+	got, report, err := registry.MigrateDefinition(def, "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Version != "2" || len(got.Filters.Events) != 1 {
+		t.Fatalf("unexpected definition %+v", got)
+	}
+	if !report.IsLossy() || report.Lossy[0].String() != "1 -> 2: filters.events[1]: only one filter is supported" {
+		t.Errorf("unexpected report %+v", report)
+	}
+	if def.Version != DefaultAPIVersion || len(def.Filters.Events) != 2 {
+		t.Error("MigrateDefinition modified its input")
+	}
+
+	// A definition without a version needs no migration but gets one.
+	unversioned := testDefinition()
+	unversioned.Version = ""
+	got, report, err = registry.MigrateDefinition(unversioned, DefaultAPIVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Version != DefaultAPIVersion || len(report.Steps) != 1 {
+		t.Errorf("unexpected result %+v, report %+v", got, report)
+	}
+
+	// Migrations chain through intermediate versions.
+	registry.Register("2", "3", func(doc map[string]interface{}) ([]LossyChange, error) { return nil, nil })
+	_, report, err = registry.MigrateDefinition(def, "3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(report.Steps, []string{"1", "2", "3"}) {
+		t.Errorf("unexpected steps %v", report.Steps)
+	}
+
+	// A step leaving a field the definition cannot hold fails.
+	registry.Register("3", "4", func(doc map[string]interface{}) ([]LossyChange, error) {
+		doc["tags"] = []interface{}{"a"}
+		return nil, nil
+	})
+	if _, _, err := registry.MigrateDefinition(def, "4"); err == nil {
+		t.Error("expected error for a field left by the migration")
+	}
+
+	if _, _, err := registry.MigrateDefinition(def, "0"); err == nil {
+		t.Error("expected error without a downgrade path")
+	}
+	var verr *ValidationError
+	if _, _, err := registry.MigrateDefinition(nil, "2"); !errors.As(err, &verr) {
+		t.Errorf("expected validation error for nil definition, got %v", err)
+	}
+}
+
+func TestMigrationRegistryRegister(t *testing.T) {
+	registry := NewMigrationRegistry()
+	noop := func(doc map[string]interface{}) ([]LossyChange, error) { return nil, nil }
+
+	if err := registry.Register("1", "2", noop); err != nil {
+		t.Fatal(err)
+	}
+	var cerr *ConfigError
+	for _, err := range []error{
+		registry.Register("1", "2", noop),
+		registry.Register("1", "1", noop),
+		registry.Register("", "2", noop),
+		registry.Register("2", "3", nil),
+	} {
+		if !errors.As(err, &cerr) {
+			t.Errorf("expected config error, got %v", err)
+		}
+	}
+}
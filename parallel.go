@@ -0,0 +1,126 @@
+package chainhooks
+
+import (
+	"context"
+	"sync"
+)
+
+// ============================================================================
+// Parallel Fetching
+// ============================================================================
+
+// ListChainhooksParallel returns every chainhook in the account. It fetches
+// the first page to learn the total and then fetches the remaining pages
+// concurrently, with at most ClientConfig.MaxConcurrency requests in flight.
+// A pageSize of zero uses DefaultPageSize.
+//
+// Chainhooks are returned in listing order. Hooks that appear on more than
+// one page because the listing changed during the fetch are returned once.
+// The first failing request cancels the others and its error is returned.
+func (c *Client) ListChainhooksParallel(ctx context.Context, pageSize uint64) ([]Chainhook, error) {
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	first, err := c.GetChainhooks(ctx, NewPaginationOptions(0, pageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	// The server may cap the page size below the one requested
+	step := pageSize
+	if n := uint64(len(first.Chainhooks)); n > 0 && n < step {
+		step = n
+	}
+
+	pages := [][]Chainhook{first.Chainhooks}
+	if first.Total > step && len(first.Chainhooks) > 0 {
+		remaining := (first.Total - 1) / step
+		pages = append(pages, make([][]Chainhook, remaining)...)
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var once sync.Once
+		var firstErr error
+		c.forEachConcurrently(int(remaining), func(i int) {
+			offset := uint64(i+1) * step
+			resp, err := c.GetChainhooks(ctx, NewPaginationOptions(offset, step))
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			pages[i+1] = resp.Chainhooks
+		})
+		if firstErr != nil {
+			return nil, firstErr
+		}
+	}
+
+	seen := make(map[UUID]struct{}, first.Total)
+	hooks := make([]Chainhook, 0, first.Total)
+	for _, page := range pages {
+		for _, hook := range page {
+			if _, dup := seen[hook.UUID]; dup {
+				continue
+			}
+			seen[hook.UUID] = struct{}{}
+			hooks = append(hooks, hook)
+		}
+	}
+
+	return hooks, nil
+}
+
+// ChainhookResult is the outcome of fetching a single chainhook in a batch.
+type ChainhookResult struct {
+	UUID      UUID
+	Chainhook *Chainhook
+	Err       error
+}
+
+// GetChainhooksByUUID fetches the given chainhooks concurrently, with at most
+// ClientConfig.MaxConcurrency requests in flight. The results are returned in
+// the order of uuids, each carrying either the chainhook or the error from
+// fetching it. The returned error is non-nil only if ctx ended before every
+// chainhook was fetched.
+func (c *Client) GetChainhooksByUUID(ctx context.Context, uuids []UUID) ([]ChainhookResult, error) {
+	results := make([]ChainhookResult, len(uuids))
+	c.forEachConcurrently(len(uuids), func(i int) {
+		hook, err := c.GetChainhook(ctx, uuids[i])
+		results[i] = ChainhookResult{
+			UUID:      uuids[i],
+			Chainhook: hook,
+			Err:       err,
+		}
+	})
+
+	return results, ctx.Err()
+}
+
+// forEachConcurrently calls fn for every index in [0, n), running at most
+// c.concurrency calls at once, and returns when all calls have finished.
+func (c *Client) forEachConcurrently(n int, fn func(i int)) {
+	concurrency := c.concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultMaxConcurrency
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
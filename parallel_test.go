@@ -0,0 +1,115 @@
+package chainhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingLimiter counts the requests it admits.
+type countingLimiter struct {
+	waits int32
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	atomic.AddInt32(&l.waits, 1)
+	return ctx.Err()
+}
+
+// newListingServer serves a listing of total hooks and tracks the maximum
+// number of concurrent requests.
+func newListingServer(total int, maxInFlight *int32) *httptest.Server {
+	var inFlight int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		if uuid := strings.TrimPrefix(r.URL.Path, "/chainhooks/me/"); uuid != r.URL.Path {
+			if uuid == "missing" {
+				http.Error(w, `{"message":"not found"}`, http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(Chainhook{UUID: UUID(uuid)})
+			return
+		}
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		resp := PaginatedChainhookResponse{Total: uint64(total), Offset: uint64(offset), Limit: uint64(limit)}
+		for i := offset; i < offset+limit && i < total; i++ {
+			resp.Chainhooks = append(resp.Chainhooks, Chainhook{UUID: UUID(fmt.Sprintf("hook-%d", i))})
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func TestListChainhooksParallel(t *testing.T) {
+	var maxInFlight int32
+	server := newListingServer(95, &maxInFlight)
+	defer server.Close()
+
+	limiter := &countingLimiter{}
+	client := NewClientWithConfig(&ClientConfig{
+		BaseURL:        server.URL,
+		MaxConcurrency: 3,
+		RateLimiter:    limiter,
+	})
+
+	hooks, err := client.ListChainhooksParallel(context.Background(), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hooks) != 95 {
+		t.Fatalf("expected 95 hooks, got %d", len(hooks))
+	}
+	for i, hook := range hooks {
+		if want := UUID(fmt.Sprintf("hook-%d", i)); hook.UUID != want {
+			t.Fatalf("hook %d: got %s, want %s", i, hook.UUID, want)
+		}
+	}
+	if max := atomic.LoadInt32(&maxInFlight); max > 3 {
+		t.Fatalf("expected at most 3 concurrent requests, got %d", max)
+	}
+	if waits := atomic.LoadInt32(&limiter.waits); waits != 10 {
+		t.Fatalf("expected 10 rate-limited requests, got %d", waits)
+	}
+}
+
+func TestGetChainhooksByUUID(t *testing.T) {
+	var maxInFlight int32
+	server := newListingServer(0, &maxInFlight)
+	defer server.Close()
+
+	client := NewClientWithConfig(&ClientConfig{BaseURL: server.URL})
+
+	results, err := client.GetChainhooksByUUID(context.Background(), []UUID{"a", "missing", "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if results[0].Chainhook == nil || results[0].Chainhook.UUID != "a" || results[0].Err != nil {
+		t.Fatalf("unexpected first result %+v", results[0])
+	}
+	if !IsNotFound(results[1].Err) || results[1].UUID != "missing" {
+		t.Fatalf("expected not found for second result, got %+v", results[1])
+	}
+	if results[2].Chainhook == nil || results[2].Chainhook.UUID != "b" {
+		t.Fatalf("unexpected third result %+v", results[2])
+	}
+}
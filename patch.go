@@ -0,0 +1,749 @@
+package chainhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ============================================================================
+// Chainhook Patch
+// ============================================================================
+
+// ChainhookPatch describes a partial update to a chainhook definition. Only
+// the fields it changes are sent, so concurrent changes to other fields are
+// not overwritten.
+//
+//	patch := chainhooks.NewChainhookPatch().
+//		SetWebhookURL("https://example.com/new-webhook").
+//		AddFilter(&chainhooks.ContractDeployFilter{})
+//	hook, err := client.PatchChainhook(ctx, uuid, patch)
+type ChainhookPatch struct {
+	name       *string
+	webhookURL *string
+	options    *ChainhookOptions
+	add        []EventFilter
+	remove     []EventFilter
+	ifMatch    string
+	err        error
+}
+
+// NewChainhookPatch creates an empty patch.
+func NewChainhookPatch() *ChainhookPatch {
+	return &ChainhookPatch{}
+}
+
+// SetName renames the chainhook.
+func (p *ChainhookPatch) SetName(name string) *ChainhookPatch {
+	if name == "" && p.err == nil {
+		p.err = &ValidationError{Field: "name", Reason: "name cannot be empty"}
+	}
+	p.name = &name
+	return p
+}
+
+// SetWebhookURL changes the webhook URL.
+func (p *ChainhookPatch) SetWebhookURL(url string) *ChainhookPatch {
+	if url == "" && p.err == nil {
+		p.err = &ValidationError{Field: "action.url", Reason: "webhook URL cannot be empty"}
+	}
+	p.webhookURL = &url
+	return p
+}
+
+// SetOptions changes every option that is set in opts; options left nil are
+// not changed. Calling SetOptions again merges with the earlier options.
+func (p *ChainhookPatch) SetOptions(opts *ChainhookOptions) *ChainhookPatch {
+	if opts == nil {
+		return p
+	}
+	if p.options == nil {
+		p.options = &ChainhookOptions{}
+	}
+	mergeOptions(p.options, opts)
+	return p
+}
+
+// AddFilter adds filters to the definition. Filters that are already present
+// are not added again, so applying the same patch twice is harmless.
+func (p *ChainhookPatch) AddFilter(filters ...EventFilter) *ChainhookPatch {
+	for _, filter := range filters {
+		if filter == nil && p.err == nil {
+			p.err = &ValidationError{Field: "filters.events", Reason: "cannot add a nil filter"}
+		}
+	}
+	p.add = append(p.add, filters...)
+	return p
+}
+
+// RemoveFilter removes filters from the definition. Every filter equal to
+// one of filters is removed, comparing canonical forms so that principal
+// spelling does not matter. Applying the patch fails if a filter is not
+// present, as ChainhookBuilder.RemoveFilter does.
+func (p *ChainhookPatch) RemoveFilter(filters ...EventFilter) *ChainhookPatch {
+	for _, filter := range filters {
+		if filter == nil && p.err == nil {
+			p.err = &ValidationError{Field: "filters.events", Reason: "cannot remove a nil filter"}
+		}
+	}
+	p.remove = append(p.remove, filters...)
+	return p
+}
+
+// IfMatch makes the patch apply only to a definition with the given
+// fingerprint (see ChainhookDefinition.Fingerprint). Applying it to any other
+// definition fails with a *FingerprintMismatchError.
+func (p *ChainhookPatch) IfMatch(fingerprint string) *ChainhookPatch {
+	p.ifMatch = fingerprint
+	return p
+}
+
+// changesFilters reports whether the patch adds or removes filters.
+func (p *ChainhookPatch) changesFilters() bool {
+	return len(p.add) > 0 || len(p.remove) > 0
+}
+
+// Apply applies the patch to a copy of current and returns the validated
+// result. current is not modified.
+func (p *ChainhookPatch) Apply(current *ChainhookDefinition) (*ChainhookDefinition, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	if current == nil {
+		return nil, &ValidationError{Field: "definition", Reason: "definition cannot be nil"}
+	}
+	if err := checkFingerprint(current, p.ifMatch); err != nil {
+		return nil, err
+	}
+
+	updated := *current
+	if p.name != nil {
+		updated.Name = *p.name
+	}
+	if p.webhookURL != nil {
+		updated.Action.URL = *p.webhookURL
+	}
+	if p.options != nil {
+		options := ChainhookOptions{}
+		if current.Options != nil {
+			options = *current.Options
+		}
+		mergeOptions(&options, p.options)
+		updated.Options = &options
+	}
+
+	events, err := p.applyFilters(current.Filters.Events)
+	if err != nil {
+		return nil, err
+	}
+	updated.Filters.Events = events
+
+	if err := updated.Validate(); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// applyFilters returns a new filter list with the patch's filters removed
+// and added.
+func (p *ChainhookPatch) applyFilters(current []EventFilter) ([]EventFilter, error) {
+	events := append([]EventFilter(nil), current...)
+
+	for _, filter := range p.remove {
+		var err error
+		if events, err = removeFilter(events, filter); err != nil {
+			return nil, err
+		}
+	}
+
+	present := countFilters(events)
+	for _, filter := range p.add {
+		key := filterKey(filter)
+		if present[key] > 0 {
+			continue
+		}
+		present[key]++
+		events = append(events, filter)
+	}
+	return events, nil
+}
+
+// removeFilter returns events without every filter equal to filter, or an
+// error if there is none.
+func removeFilter(events []EventFilter, filter EventFilter) ([]EventFilter, error) {
+	if filter == nil {
+		return nil, &ValidationError{Field: "filters.events", Reason: "cannot remove a nil filter"}
+	}
+	key := filterKey(filter)
+	kept := make([]EventFilter, 0, len(events))
+	for _, existing := range events {
+		if filterKey(existing) != key {
+			kept = append(kept, existing)
+		}
+	}
+	if len(kept) == len(events) {
+		return nil, &ValidationError{
+			Field:  "filters.events",
+			Reason: fmt.Sprintf("cannot remove %s filter: not present in the definition", filter.EventType()),
+		}
+	}
+	return kept, nil
+}
+
+// MergePatch returns the minimal RFC 7386 JSON Merge Patch that applies the
+// patch to current. Only changed fields are included; because a merge patch
+// replaces arrays, the full filter list is included when filters change.
+func (p *ChainhookPatch) MergePatch(current *ChainhookDefinition) ([]byte, error) {
+	updated, err := p.Apply(current)
+	if err != nil {
+		return nil, err
+	}
+	return p.mergePatchBody(updated.Filters)
+}
+
+// mergePatchBody encodes the changed fields, with filters as the complete
+// filter list after the patch is applied.
+func (p *ChainhookPatch) mergePatchBody(filters ChainhookFilters) ([]byte, error) {
+	body := make(map[string]interface{})
+	if p.name != nil {
+		body["name"] = *p.name
+	}
+	if p.webhookURL != nil {
+		body["action"] = map[string]interface{}{"url": *p.webhookURL}
+	}
+	if p.options != nil {
+		body["options"] = p.options
+	}
+	if p.changesFilters() {
+		body["filters"] = filters
+	}
+	return json.Marshal(body)
+}
+
+// validateFields checks the fields set by the patch on their own, for when
+// the patch is sent without the current definition.
+func (p *ChainhookPatch) validateFields() error {
+	v := &validator{}
+	if p.webhookURL != nil {
+		v.action("action", ChainhookAction{Type: ActionTypeHTTPPost, URL: *p.webhookURL})
+	}
+	if p.options != nil {
+		v.options("options", p.options)
+	}
+	return v.err()
+}
+
+// mergeOptions copies every option set in src into dst.
+func mergeOptions(dst, src *ChainhookOptions) {
+	for _, field := range []struct{ dst, src **bool }{
+		{&dst.EnableOnRegistration, &src.EnableOnRegistration},
+		{&dst.DecodeClarityValues, &src.DecodeClarityValues},
+		{&dst.IncludeContractABI, &src.IncludeContractABI},
+		{&dst.IncludeContractSourceCode, &src.IncludeContractSourceCode},
+		{&dst.IncludePostConditions, &src.IncludePostConditions},
+		{&dst.IncludeRawTransactions, &src.IncludeRawTransactions},
+		{&dst.IncludeBlockSignatures, &src.IncludeBlockSignatures},
+		{&dst.IncludeBlockMetadata, &src.IncludeBlockMetadata},
+	} {
+		if *field.src != nil {
+			*field.dst = *field.src
+		}
+	}
+	if src.ExpireAfterEvaluations != nil {
+		dst.ExpireAfterEvaluations = src.ExpireAfterEvaluations
+	}
+	if src.ExpireAfterOccurrences != nil {
+		dst.ExpireAfterOccurrences = src.ExpireAfterOccurrences
+	}
+}
+
+// checkFingerprint returns a *FingerprintMismatchError if expected is set and
+// differs from the fingerprint of def.
+func checkFingerprint(def *ChainhookDefinition, expected string) error {
+	if expected == "" {
+		return nil
+	}
+	actual, err := def.Fingerprint()
+	if err != nil {
+		return err
+	}
+	if actual != expected {
+		return &FingerprintMismatchError{Expected: expected, Actual: actual}
+	}
+	return nil
+}
+
+// PatchChainhook applies a partial update to a chainhook and sends only the
+// changed fields as a JSON Merge Patch.
+//
+// The current definition is fetched when the patch changes filters or has an
+// IfMatch fingerprint, so that the full filter list can be computed and the
+// fingerprint checked. It is always read from the server, bypassing the
+// cache and request coalescing. The fingerprint check happens client-side before the
+// request is sent; it detects changes made before the fetch, not changes made
+// between the fetch and the update.
+func (c *Client) PatchChainhook(ctx context.Context, uuid UUID, patch *ChainhookPatch) (*Chainhook, error) {
+	if uuid == "" {
+		return nil, &ValidationError{
+			Field:  "uuid",
+			Reason: "uuid cannot be empty",
+		}
+	}
+
+	if patch == nil {
+		return nil, &ValidationError{
+			Field:  "patch",
+			Reason: "patch cannot be nil",
+		}
+	}
+	if patch.err != nil {
+		return nil, patch.err
+	}
+
+	var body []byte
+	if patch.changesFilters() || patch.ifMatch != "" {
+		var current Chainhook
+		err := c.uncachedRequest(ctx, fmt.Sprintf(EndpointChainhook, uuid), &current)
+		if err != nil {
+			return nil, err
+		}
+		if body, err = patch.MergePatch(current.Definition); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := patch.validateFields(); err != nil {
+			return nil, err
+		}
+		var err error
+		if body, err = patch.mergePatchBody(ChainhookFilters{}); err != nil {
+			return nil, err
+		}
+	}
+
+	path := fmt.Sprintf(EndpointChainhook, uuid)
+	var result Chainhook
+	err := c.request(ctx, MethodPATCH, path, json.RawMessage(body), &result)
+	c.invalidate(uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ============================================================================
+// JSON Merge Patch and JSON Patch
+// ============================================================================
+
+// ApplyMergePatch applies an RFC 7386 JSON Merge Patch to a copy of def and
+// returns the validated result. If ifMatch is not empty, def must have that
+// fingerprint or a *FingerprintMismatchError is returned.
+func ApplyMergePatch(def *ChainhookDefinition, patch []byte, ifMatch string) (*ChainhookDefinition, error) {
+	doc, err := definitionDocument(def, ifMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	patchDoc, err := decodeJSONValue(patch)
+	if err != nil {
+		return nil, fmt.Errorf("invalid merge patch: %w", err)
+	}
+	return definitionFromDocument(mergePatch(doc, patchDoc))
+}
+
+// mergePatch implements the MergePatch function of RFC 7386.
+func mergePatch(target, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObj, ok := target.(map[string]interface{})
+	if !ok {
+		targetObj = make(map[string]interface{})
+	}
+	for key, value := range patchObj {
+		if value == nil {
+			delete(targetObj, key)
+			continue
+		}
+		targetObj[key] = mergePatch(targetObj[key], value)
+	}
+	return targetObj
+}
+
+// JSONPatchOperation is a single RFC 6902 JSON Patch operation.
+type JSONPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// ApplyJSONPatch applies an RFC 6902 JSON Patch (an array of operations) to a
+// copy of def and returns the validated result. Operations are applied in
+// order and the patch fails as a whole if any operation fails, including a
+// failed "test". If ifMatch is not empty, def must have that fingerprint or a
+// *FingerprintMismatchError is returned.
+func ApplyJSONPatch(def *ChainhookDefinition, patch []byte, ifMatch string) (*ChainhookDefinition, error) {
+	doc, err := definitionDocument(def, ifMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	var ops []JSONPatchOperation
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return nil, fmt.Errorf("invalid JSON patch: %w", err)
+	}
+
+	for i, op := range ops {
+		if doc, err = applyPatchOperation(doc, op); err != nil {
+			return nil, fmt.Errorf("JSON patch operation %d (%s %q): %w", i, op.Op, op.Path, err)
+		}
+	}
+	return definitionFromDocument(doc)
+}
+
+func applyPatchOperation(doc interface{}, op JSONPatchOperation) (interface{}, error) {
+	path, err := parseJSONPointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "add", "replace", "test":
+		if op.Value == nil {
+			return nil, fmt.Errorf("missing value")
+		}
+		value, err := decodeJSONValue(op.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value: %w", err)
+		}
+		switch op.Op {
+		case "add":
+			return pointerAdd(doc, path, value)
+		case "replace":
+			if len(path) == 0 {
+				return value, nil
+			}
+			if doc, _, err = pointerRemove(doc, path); err != nil {
+				return nil, err
+			}
+			return pointerAdd(doc, path, value)
+		default:
+			current, err := pointerGet(doc, path)
+			if err != nil {
+				return nil, err
+			}
+			if !jsonValuesEqual(current, value) {
+				return nil, fmt.Errorf("test failed")
+			}
+			return doc, nil
+		}
+	case "remove":
+		doc, _, err = pointerRemove(doc, path)
+		return doc, err
+	case "move", "copy":
+		from, err := parseJSONPointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		var value interface{}
+		if op.Op == "move" {
+			if isPointerPrefix(from, path) && len(from) < len(path) {
+				return nil, fmt.Errorf("cannot move %q into one of its children", op.From)
+			}
+			doc, value, err = pointerRemove(doc, from)
+		} else {
+			value, err = pointerGet(doc, from)
+			if err == nil {
+				value, err = deepCopyJSON(value)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return pointerAdd(doc, path, value)
+	default:
+		return nil, fmt.Errorf("unsupported operation %q", op.Op)
+	}
+}
+
+// parseJSONPointer splits an RFC 6901 JSON Pointer into unescaped tokens.
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q: must start with '/'", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+	return tokens, nil
+}
+
+func isPointerPrefix(prefix, path []string) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+	for i := range prefix {
+		if prefix[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// arrayIndex parses an array index token. allowEnd permits the index one
+// past the last element, and "-" for it.
+func arrayIndex(token string, length int, allowEnd bool) (int, error) {
+	if token == "-" && allowEnd {
+		return length, nil
+	}
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	max := length - 1
+	if allowEnd {
+		max = length
+	}
+	if index > max {
+		return 0, fmt.Errorf("array index %d out of range", index)
+	}
+	return index, nil
+}
+
+// pointerGet returns the value at path.
+func pointerGet(doc interface{}, path []string) (interface{}, error) {
+	for _, token := range path {
+		switch node := doc.(type) {
+		case map[string]interface{}:
+			value, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("member %q not found", token)
+			}
+			doc = value
+		case []interface{}:
+			index, err := arrayIndex(token, len(node), false)
+			if err != nil {
+				return nil, err
+			}
+			doc = node[index]
+		default:
+			return nil, fmt.Errorf("cannot traverse into %q", token)
+		}
+	}
+	return doc, nil
+}
+
+// pointerAdd adds value at path and returns the updated document.
+func pointerAdd(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	token, rest := path[0], path[1:]
+
+	switch node := doc.(type) {
+	case map[string]interface{}:
+		if len(rest) == 0 {
+			node[token] = value
+			return node, nil
+		}
+		child, ok := node[token]
+		if !ok {
+			return nil, fmt.Errorf("member %q not found", token)
+		}
+		updated, err := pointerAdd(child, rest, value)
+		if err != nil {
+			return nil, err
+		}
+		node[token] = updated
+		return node, nil
+	case []interface{}:
+		if len(rest) == 0 {
+			index, err := arrayIndex(token, len(node), true)
+			if err != nil {
+				return nil, err
+			}
+			node = append(node, nil)
+			copy(node[index+1:], node[index:])
+			node[index] = value
+			return node, nil
+		}
+		index, err := arrayIndex(token, len(node), false)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := pointerAdd(node[index], rest, value)
+		if err != nil {
+			return nil, err
+		}
+		node[index] = updated
+		return node, nil
+	default:
+		return nil, fmt.Errorf("cannot traverse into %q", token)
+	}
+}
+
+// pointerRemove removes the value at path and returns the updated document
+// and the removed value.
+func pointerRemove(doc interface{}, path []string) (interface{}, interface{}, error) {
+	if len(path) == 0 {
+		return nil, nil, fmt.Errorf("cannot remove the whole document")
+	}
+	token, rest := path[0], path[1:]
+
+	switch node := doc.(type) {
+	case map[string]interface{}:
+		child, ok := node[token]
+		if !ok {
+			return nil, nil, fmt.Errorf("member %q not found", token)
+		}
+		if len(rest) == 0 {
+			delete(node, token)
+			return node, child, nil
+		}
+		updated, removed, err := pointerRemove(child, rest)
+		if err != nil {
+			return nil, nil, err
+		}
+		node[token] = updated
+		return node, removed, nil
+	case []interface{}:
+		index, err := arrayIndex(token, len(node), false)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(rest) == 0 {
+			removed := node[index]
+			return append(node[:index], node[index+1:]...), removed, nil
+		}
+		updated, removed, err := pointerRemove(node[index], rest)
+		if err != nil {
+			return nil, nil, err
+		}
+		node[index] = updated
+		return node, removed, nil
+	default:
+		return nil, nil, fmt.Errorf("cannot traverse into %q", token)
+	}
+}
+
+// definitionDocument checks the fingerprint of def and returns it as a
+// generic JSON document.
+func definitionDocument(def *ChainhookDefinition, ifMatch string) (interface{}, error) {
+	if def == nil {
+		return nil, &ValidationError{Field: "definition", Reason: "definition cannot be nil"}
+	}
+	if err := checkFingerprint(def, ifMatch); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(def)
+	if err != nil {
+		return nil, err
+	}
+	return decodeJSONValue(data)
+}
+
+// definitionFromDocument decodes and validates a patched document.
+func definitionFromDocument(doc interface{}) (*ChainhookDefinition, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	var def ChainhookDefinition
+	if err := json.Unmarshal(data, &def); err != nil {
+		return nil, fmt.Errorf("patched definition is invalid: %w", err)
+	}
+	if err := def.Validate(); err != nil {
+		return nil, err
+	}
+	return &def, nil
+}
+
+// jsonValuesEqual reports whether two decoded JSON values are equal, as
+// required by the JSON Patch "test" operation. Numbers are compared by value,
+// so 1, 1.0 and 1e0 are equal whatever type they were decoded as.
+func jsonValuesEqual(a, b interface{}) bool {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for key, value := range a {
+			other, ok := b[key]
+			if !ok || !jsonValuesEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !jsonValuesEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if x, ok := jsonNumberValue(a); ok {
+		y, ok := jsonNumberValue(b)
+		return ok && x.Cmp(y) == 0
+	}
+	return a == b
+}
+
+// jsonNumberValue returns the exact value of a decoded JSON number.
+func jsonNumberValue(v interface{}) (*big.Rat, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		return new(big.Rat).SetString(string(n))
+	case float64:
+		r := new(big.Rat)
+		if r.SetFloat64(n) == nil {
+			return nil, false
+		}
+		return r, true
+	case int:
+		return new(big.Rat).SetInt64(int64(n)), true
+	case int64:
+		return new(big.Rat).SetInt64(n), true
+	case uint64:
+		return new(big.Rat).SetUint64(n), true
+	default:
+		return nil, false
+	}
+}
+
+// decodeJSONValue decodes JSON into generic values, keeping numbers exact.
+func decodeJSONValue(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func deepCopyJSON(value interface{}) (interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return decodeJSONValue(data)
+}
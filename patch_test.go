@@ -0,0 +1,259 @@
+package chainhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testAddress = "SP000000000000000000002Q6VF78"
+
+func testDefinition() *ChainhookDefinition {
+	return &ChainhookDefinition{
+		Name:    "my-hook",
+		Version: DefaultAPIVersion,
+		Chain:   ChainStacks,
+		Network: NetworkMainnet,
+		Filters: NewChainhookFilters(
+			&STXTransferFilter{Sender: PrincipalStandard(testAddress)},
+			&ContractDeployFilter{},
+		),
+		Action: ChainhookAction{Type: ActionTypeHTTPPost, URL: "https://example.com/webhook"},
+	}
+}
+
+func TestChainhookPatchMergePatch(t *testing.T) {
+	patch := NewChainhookPatch().
+		SetWebhookURL("https://example.com/new").
+		SetOptions(&ChainhookOptions{DecodeClarityValues: BoolPtr(true)}).
+		RemoveFilter(&STXTransferFilter{Sender: PrincipalStandard("sp000000000000000000002q6vf78")}).
+		AddFilter(&CoinbaseFilter{}, &ContractDeployFilter{})
+
+	body, err := patch.MergePatch(testDefinition())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"action":{"url":"https://example.com/new"},"filters":{"events":[{"type":"contract_deploy"},{"type":"coinbase"}]},"options":{"decode_clarity_values":true}}`
+	if string(body) != want {
+		t.Fatalf("got %s\nwant %s", body, want)
+	}
+
+	// The merge patch applied to the original yields the same definition as Apply.
+	applied, err := patch.Apply(testDefinition())
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged, err := ApplyMergePatch(testDefinition(), body, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := DiffDefinitions(applied, merged); !diff.Empty() {
+		t.Fatalf("merge patch differs from Apply:\n%s", diff)
+	}
+}
+
+func TestChainhookPatchErrors(t *testing.T) {
+	_, err := NewChainhookPatch().RemoveFilter(&CoinbaseFilter{}).Apply(testDefinition())
+	var verr *ValidationError
+	if !errors.As(err, &verr) || verr.Field != "filters.events" {
+		t.Fatalf("expected filters.events validation error, got %v", err)
+	}
+
+	_, err = NewChainhookPatch().AddFilter(nil).Apply(testDefinition())
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected validation error for nil filter, got %v", err)
+	}
+
+	_, err = NewChainhookPatch().SetName("renamed").IfMatch("stale").Apply(testDefinition())
+	var mismatch *FingerprintMismatchError
+	if !errors.As(err, &mismatch) || mismatch.Expected != "stale" {
+		t.Fatalf("expected fingerprint mismatch, got %v", err)
+	}
+}
+
+func TestRemoveFilterSemantics(t *testing.T) {
+	// A definition with a duplicated filter, one spelled differently
+	def := testDefinition()
+	def.Filters = NewChainhookFilters(
+		&CoinbaseFilter{},
+		&STXTransferFilter{Sender: PrincipalStandard(testAddress)},
+		&STXTransferFilter{Sender: PrincipalStandard("sp000000000000000000002q6vf78")},
+	)
+	transfer := &STXTransferFilter{Sender: PrincipalStandard(testAddress)}
+	onlyCoinbase := func(filters []EventFilter) bool {
+		return len(filters) == 1 && filters[0].EventType() == EventTypeCoinbase
+	}
+
+	// Both the patch and the builder remove every matching filter...
+	patched, err := NewChainhookPatch().RemoveFilter(transfer).Apply(def)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !onlyCoinbase(patched.Filters.Events) {
+		t.Errorf("patch left %v", patched.Filters.Events)
+	}
+	built, err := BuilderFrom(def).RemoveFilter(transfer).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !onlyCoinbase(built.Filters.Events) {
+		t.Errorf("builder left %v", built.Filters.Events)
+	}
+
+	// ...and both fail when no filter matches
+	missing := &ContractDeployFilter{}
+	var verr *ValidationError
+	if _, err := NewChainhookPatch().RemoveFilter(missing).Apply(patched); !errors.As(err, &verr) {
+		t.Errorf("expected patch to fail, got %v", err)
+	}
+	var berrs BuilderErrors
+	if _, err := BuilderFrom(patched).RemoveFilter(missing).Build(); !errors.As(err, &berrs) || len(berrs) != 1 || berrs[0].Method != "RemoveFilter" {
+		t.Errorf("expected a RemoveFilter builder error, got %v", err)
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	def := testDefinition()
+	def.Options = &ChainhookOptions{IncludeContractABI: BoolPtr(true)}
+	fingerprint, _ := def.Fingerprint()
+
+	got, err := ApplyMergePatch(def, []byte(`{"name":"renamed","options":{"include_contract_abi":null}}`), fingerprint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "renamed" || got.Options == nil || got.Options.IncludeContractABI != nil {
+		t.Fatalf("unexpected result %+v", got)
+	}
+	if def.Name != "my-hook" {
+		t.Fatal("ApplyMergePatch modified its input")
+	}
+
+	if _, err := ApplyMergePatch(def, []byte(`{"action":null}`), ""); err == nil {
+		t.Fatal("expected validation error after removing the action")
+	}
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	patch := `[
+		{"op": "test", "path": "/name", "value": "my-hook"},
+		{"op": "replace", "path": "/action/url", "value": "https://example.com/new"},
+		{"op": "add", "path": "/filters/events/-", "value": {"type": "coinbase"}},
+		{"op": "remove", "path": "/filters/events/0"},
+		{"op": "copy", "from": "/name", "path": "/version"},
+		{"op": "replace", "path": "/version", "value": "1"}
+	]`
+	got, err := ApplyJSONPatch(testDefinition(), []byte(patch), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Action.URL != "https://example.com/new" {
+		t.Fatalf("unexpected URL %q", got.Action.URL)
+	}
+	if len(got.Filters.Events) != 2 || got.Filters.Events[0].EventType() != EventTypeContractDeploy || got.Filters.Events[1].EventType() != EventTypeCoinbase {
+		t.Fatalf("unexpected filters %v", got.Filters.Events)
+	}
+
+	// Numbers are tested by value, not by spelling
+	numeric := `[
+		{"op": "add", "path": "/options", "value": {"expire_after_evaluations": 10}},
+		{"op": "test", "path": "/options/expire_after_evaluations", "value": 1e1},
+		{"op": "test", "path": "/options", "value": {"expire_after_evaluations": 10.0}}
+	]`
+	if _, err := ApplyJSONPatch(testDefinition(), []byte(numeric), ""); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		patch string
+	}{
+		{"failed test", `[{"op": "test", "path": "/name", "value": "other"}]`},
+		{"failed numeric test", `[{"op": "add", "path": "/options", "value": {"expire_after_evaluations": 10}}, {"op": "test", "path": "/options/expire_after_evaluations", "value": 10.5}]`},
+		{"missing member", `[{"op": "remove", "path": "/options"}]`},
+		{"index out of range", `[{"op": "add", "path": "/filters/events/5", "value": {"type": "coinbase"}}]`},
+		{"unknown op", `[{"op": "frobnicate", "path": "/name"}]`},
+		{"move into child", `[{"op": "move", "from": "/filters", "path": "/filters/events"}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ApplyJSONPatch(testDefinition(), []byte(tt.patch), ""); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestPatchChainhook(t *testing.T) {
+	var patched []byte
+	current := &Chainhook{UUID: "uuid-1", Definition: testDefinition()}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			patched, _ = io.ReadAll(r.Body)
+		}
+		json.NewEncoder(w).Encode(current)
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(&ClientConfig{BaseURL: server.URL})
+
+	if _, err := client.PatchChainhook(context.Background(), "uuid-1", NewChainhookPatch().SetWebhookURL("https://example.com/new")); err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"action":{"url":"https://example.com/new"}}`; string(patched) != want {
+		t.Fatalf("got body %s, want %s", patched, want)
+	}
+
+	fingerprint, _ := testDefinition().Fingerprint()
+	patch := NewChainhookPatch().AddFilter(&CoinbaseFilter{}).IfMatch(fingerprint)
+	if _, err := client.PatchChainhook(context.Background(), "uuid-1", patch); err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"filters":{"events":[{"type":"stx_transfer","sender":{"standard":"` + testAddress + `"}},{"type":"contract_deploy"},{"type":"coinbase"}]}}`; string(patched) != want {
+		t.Fatalf("got body %s, want %s", patched, want)
+	}
+
+	patched = nil
+	_, err := client.PatchChainhook(context.Background(), "uuid-1", NewChainhookPatch().SetName("x").IfMatch("stale"))
+	var mismatch *FingerprintMismatchError
+	if !errors.As(err, &mismatch) || patched != nil {
+		t.Fatalf("expected fingerprint mismatch without a request, got %v", err)
+	}
+}
+
+func TestPatchChainhookBypassesCache(t *testing.T) {
+	var mu sync.Mutex
+	current := &Chainhook{UUID: "uuid-1", Definition: testDefinition()}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		json.NewEncoder(w).Encode(current)
+	}))
+	defer server.Close()
+
+	client := NewClientWithConfig(&ClientConfig{
+		BaseURL:          server.URL,
+		Cache:            NewMemoryCache(),
+		CacheTTL:         time.Hour,
+		CoalesceRequests: true,
+	})
+	if _, err := client.GetChainhook(context.Background(), "uuid-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Changed by someone else after the cached read
+	mu.Lock()
+	current.Definition.Name = "renamed"
+	fingerprint, _ := current.Definition.Fingerprint()
+	mu.Unlock()
+
+	patch := NewChainhookPatch().AddFilter(&CoinbaseFilter{}).IfMatch(fingerprint)
+	if _, err := client.PatchChainhook(context.Background(), "uuid-1", patch); err != nil {
+		t.Fatalf("patch was checked against the cached definition: %v", err)
+	}
+}
@@ -0,0 +1,263 @@
+package chainhooks
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// ============================================================================
+// Chainhook Query
+// ============================================================================
+
+// ChainhookQuery selects chainhooks client-side. Every condition added to a
+// query must hold for a chainhook to match; an empty query matches every
+// chainhook.
+//
+// Conditions are added with chained method calls:
+//
+//	query := NewChainhookQuery().
+//		NameGlob("indexer-*").
+//		Network(NetworkMainnet).
+//		Status(ChainhookStatusInterrupted)
+type ChainhookQuery struct {
+	predicates []func(*Chainhook) bool
+	err        error
+}
+
+// NewChainhookQuery creates an empty ChainhookQuery.
+func NewChainhookQuery() *ChainhookQuery {
+	return &ChainhookQuery{}
+}
+
+// Where adds a custom condition.
+func (q *ChainhookQuery) Where(predicate func(hook *Chainhook) bool) *ChainhookQuery {
+	q.predicates = append(q.predicates, predicate)
+	return q
+}
+
+// UUID matches chainhooks with any of the given UUIDs.
+func (q *ChainhookQuery) UUID(uuids ...UUID) *ChainhookQuery {
+	return q.Where(func(hook *Chainhook) bool {
+		for _, uuid := range uuids {
+			if hook.UUID == uuid {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Name matches chainhooks whose name equals name.
+func (q *ChainhookQuery) Name(name string) *ChainhookQuery {
+	return q.Where(func(hook *Chainhook) bool {
+		return hook.Definition != nil && hook.Definition.Name == name
+	})
+}
+
+// NamePrefix matches chainhooks whose name starts with prefix.
+func (q *ChainhookQuery) NamePrefix(prefix string) *ChainhookQuery {
+	return q.Where(func(hook *Chainhook) bool {
+		return hook.Definition != nil && strings.HasPrefix(hook.Definition.Name, prefix)
+	})
+}
+
+// NameGlob matches chainhooks whose name matches a shell glob pattern, using
+// the syntax of path.Match.
+func (q *ChainhookQuery) NameGlob(pattern string) *ChainhookQuery {
+	if _, err := path.Match(pattern, ""); err != nil {
+		q.setErr(&ValidationError{
+			Field:  "name",
+			Reason: fmt.Sprintf("invalid glob pattern %q: %v", pattern, err),
+		})
+		return q
+	}
+	return q.Where(func(hook *Chainhook) bool {
+		if hook.Definition == nil {
+			return false
+		}
+		matched, _ := path.Match(pattern, hook.Definition.Name)
+		return matched
+	})
+}
+
+// NameRegexp matches chainhooks whose name matches a regular expression.
+func (q *ChainhookQuery) NameRegexp(expr string) *ChainhookQuery {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		q.setErr(&ValidationError{
+			Field:  "name",
+			Reason: fmt.Sprintf("invalid regular expression %q: %v", expr, err),
+		})
+		return q
+	}
+	return q.Where(func(hook *Chainhook) bool {
+		return hook.Definition != nil && re.MatchString(hook.Definition.Name)
+	})
+}
+
+// Network matches chainhooks on any of the given networks.
+func (q *ChainhookQuery) Network(networks ...Network) *ChainhookQuery {
+	return q.Where(func(hook *Chainhook) bool {
+		if hook.Definition == nil {
+			return false
+		}
+		for _, network := range networks {
+			if hook.Definition.Network == network {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Status matches chainhooks in any of the given statuses.
+func (q *ChainhookQuery) Status(statuses ...ChainhookStatus) *ChainhookQuery {
+	return q.Where(func(hook *Chainhook) bool {
+		for _, status := range statuses {
+			if hook.Status.Status == status {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Enabled matches chainhooks that are enabled or disabled.
+func (q *ChainhookQuery) Enabled(enabled bool) *ChainhookQuery {
+	return q.Where(func(hook *Chainhook) bool {
+		return hook.Status.Enabled == enabled
+	})
+}
+
+// WebhookURL matches chainhooks that deliver to exactly webhookURL.
+func (q *ChainhookQuery) WebhookURL(webhookURL string) *ChainhookQuery {
+	return q.Where(func(hook *Chainhook) bool {
+		return hook.Definition != nil && hook.Definition.Action.URL == webhookURL
+	})
+}
+
+// WebhookHost matches chainhooks whose webhook URL is on host. The
+// comparison ignores case and the port.
+func (q *ChainhookQuery) WebhookHost(host string) *ChainhookQuery {
+	return q.Where(func(hook *Chainhook) bool {
+		if hook.Definition == nil {
+			return false
+		}
+		u, err := url.Parse(hook.Definition.Action.URL)
+		if err != nil {
+			return false
+		}
+		return strings.EqualFold(u.Hostname(), host)
+	})
+}
+
+// HasEventType matches chainhooks with a filter for any of the given event types.
+func (q *ChainhookQuery) HasEventType(types ...EventType) *ChainhookQuery {
+	return q.Where(func(hook *Chainhook) bool {
+		for _, have := range definitionEventTypes(hook.Definition) {
+			for _, want := range types {
+				if have == want {
+					return true
+				}
+			}
+		}
+		return false
+	})
+}
+
+// LastOccurrenceAfter matches chainhooks whose last occurrence is at or after
+// t, in the units of ChainhookStatusInfo.LastOccurrenceAt. Chainhooks that
+// never fired do not match.
+func (q *ChainhookQuery) LastOccurrenceAfter(t int64) *ChainhookQuery {
+	return q.Where(func(hook *Chainhook) bool {
+		return hook.Status.LastOccurrenceAt != nil && *hook.Status.LastOccurrenceAt >= t
+	})
+}
+
+// LastOccurrenceBefore matches chainhooks whose last occurrence is before t,
+// in the units of ChainhookStatusInfo.LastOccurrenceAt. Chainhooks that never
+// fired do not match.
+func (q *ChainhookQuery) LastOccurrenceBefore(t int64) *ChainhookQuery {
+	return q.Where(func(hook *Chainhook) bool {
+		return hook.Status.LastOccurrenceAt != nil && *hook.Status.LastOccurrenceAt < t
+	})
+}
+
+// NeverOccurred matches chainhooks that have never fired.
+func (q *ChainhookQuery) NeverOccurred() *ChainhookQuery {
+	return q.Where(func(hook *Chainhook) bool {
+		return hook.Status.LastOccurrenceAt == nil
+	})
+}
+
+// Match reports whether hook satisfies every condition of the query.
+func (q *ChainhookQuery) Match(hook *Chainhook) bool {
+	if hook == nil {
+		return false
+	}
+	for _, predicate := range q.predicates {
+		if !predicate(hook) {
+			return false
+		}
+	}
+	return true
+}
+
+// Err returns the first error recorded while building the query, such as an
+// invalid glob or regular expression.
+func (q *ChainhookQuery) Err() error {
+	return q.err
+}
+
+// setErr records err unless an earlier error was recorded.
+func (q *ChainhookQuery) setErr(err error) {
+	if q.err == nil {
+		q.err = err
+	}
+}
+
+// ============================================================================
+// Find Methods
+// ============================================================================
+
+// FindChainhooks walks the paginated listing and returns every chainhook
+// matching query. A nil query matches every chainhook.
+func (c *Client) FindChainhooks(ctx context.Context, query *ChainhookQuery) ([]Chainhook, error) {
+	return findChainhooks(c.AllChainhooks(ctx, DefaultPageSize), query)
+}
+
+// FindByName returns every chainhook named name.
+func (c *Client) FindByName(ctx context.Context, name string) ([]Chainhook, error) {
+	return c.FindChainhooks(ctx, NewChainhookQuery().Name(name))
+}
+
+// FindByWebhookURL returns every chainhook that delivers to webhookURL.
+func (c *Client) FindByWebhookURL(ctx context.Context, webhookURL string) ([]Chainhook, error) {
+	return c.FindChainhooks(ctx, NewChainhookQuery().WebhookURL(webhookURL))
+}
+
+// findChainhooks collects the chainhooks from it that match query.
+func findChainhooks(it *ChainhookIterator, query *ChainhookQuery) ([]Chainhook, error) {
+	if query == nil {
+		query = NewChainhookQuery()
+	}
+	if err := query.Err(); err != nil {
+		return nil, err
+	}
+
+	matches := []Chainhook{}
+	for it.Next() {
+		if hook := it.Chainhook(); query.Match(hook) {
+			matches = append(matches, *hook)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,94 @@
+package chainhooks
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestChainhookQueryMatch(t *testing.T) {
+	hook := &Chainhook{
+		UUID: "uuid-1",
+		Definition: &ChainhookDefinition{
+			Name:    "indexer-tokens",
+			Network: NetworkMainnet,
+			Action:  ChainhookAction{Type: "http_post", URL: "https://hooks.example.com/tokens"},
+		},
+		Status: ChainhookStatusInfo{Status: ChainhookStatusStreaming, Enabled: true},
+	}
+	noDefinition := &Chainhook{UUID: "uuid-2"}
+
+	tests := []struct {
+		name  string
+		query *ChainhookQuery
+		hook  *Chainhook
+		want  bool
+	}{
+		{"empty", NewChainhookQuery(), hook, true},
+		{"nil hook", NewChainhookQuery(), nil, false},
+		{"name", NewChainhookQuery().Name("indexer-tokens"), hook, true},
+		{"name mismatch", NewChainhookQuery().Name("indexer"), hook, false},
+		{"name without definition", NewChainhookQuery().Name(""), noDefinition, false},
+		{"webhook url", NewChainhookQuery().WebhookURL("https://hooks.example.com/tokens"), hook, true},
+		{"webhook url mismatch", NewChainhookQuery().WebhookURL("https://hooks.example.com/"), hook, false},
+		{"webhook url without definition", NewChainhookQuery().WebhookURL(""), noDefinition, false},
+		{"status", NewChainhookQuery().Status(ChainhookStatusInterrupted, ChainhookStatusStreaming), hook, true},
+		{"status mismatch", NewChainhookQuery().Status(ChainhookStatusInterrupted), hook, false},
+		{"status none given", NewChainhookQuery().Status(), hook, false},
+		{"where", NewChainhookQuery().Where(func(h *Chainhook) bool { return h.Status.Enabled }), hook, true},
+		{"where mismatch", NewChainhookQuery().Where(func(h *Chainhook) bool { return !h.Status.Enabled }), hook, false},
+		{"uuid", NewChainhookQuery().UUID("uuid-9", "uuid-1"), hook, true},
+		{"uuid mismatch", NewChainhookQuery().UUID("uuid-9"), hook, false},
+		{"uuid without definition", NewChainhookQuery().UUID("uuid-2"), noDefinition, true},
+		{
+			"and",
+			NewChainhookQuery().UUID("uuid-1").Name("indexer-tokens").Status(ChainhookStatusStreaming),
+			hook,
+			true,
+		},
+		{
+			"and with one mismatch",
+			NewChainhookQuery().UUID("uuid-1").Name("indexer-tokens").Status(ChainhookStatusInterrupted),
+			hook,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.Match(tt.hook); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+			if err := tt.query.Err(); err != nil {
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestChainhookQueryErr(t *testing.T) {
+	tests := []struct {
+		name  string
+		query *ChainhookQuery
+		field string
+	}{
+		{"glob", NewChainhookQuery().NameGlob("[a-"), "name"},
+		{"regexp", NewChainhookQuery().NameRegexp("(a"), "name"},
+		{"first error kept", NewChainhookQuery().NameGlob("[a-").NameRegexp("(a").Name("x"), "name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var verr *ValidationError
+			if err := tt.query.Err(); !errors.As(err, &verr) || verr.Field != tt.field {
+				t.Fatalf("expected validation error on %q, got %v", tt.field, err)
+			}
+			if _, err := findChainhooks(nil, tt.query); err != tt.query.Err() {
+				t.Fatalf("findChainhooks returned %v, want the query error", err)
+			}
+		})
+	}
+
+	query := NewChainhookQuery().NameGlob("[a-").NameRegexp("(a")
+	if err := query.Err(); err == nil || !strings.Contains(err.Error(), "invalid glob pattern") {
+		t.Errorf("expected the first error to be kept, got %v", err)
+	}
+}
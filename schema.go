@@ -0,0 +1,329 @@
+package chainhooks
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/tony1908/chainhooks-client-go/stacks"
+)
+
+// ============================================================================
+// JSON Schema
+// ============================================================================
+
+//go:generate go test -run TestEmbeddedSchemas -update-schemas
+
+// JSONSchemaDialect is the JSON Schema draft used by generated schemas.
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// SchemaFiles holds the generated schemas as static files, for editors and
+// tools that cannot call JSONSchema:
+//
+//	schema/chainhook-definition.schema.json  ChainhookDefinition
+//	schema/chainhook.schema.json             Chainhook
+//	schema/chainhooks-page.schema.json       PaginatedChainhookResponse
+//
+// A test keeps them in sync with the Go types; run go generate after
+// changing a type.
+//
+//go:embed schema/*.json
+var SchemaFiles embed.FS
+
+// schemaFiles maps each embedded file to the type it describes.
+var schemaFiles = map[string]interface{}{
+	"chainhook-definition.schema.json": ChainhookDefinition{},
+	"chainhook.schema.json":            Chainhook{},
+	"chainhooks-page.schema.json":      PaginatedChainhookResponse{},
+}
+
+// Patterns for identifiers, matching what Validate accepts. Addresses are
+// in canonical c32check form: 'S', an upper-case version character, then
+// upper-case c32 characters, which exclude I, L, O and U. The checksum
+// itself cannot be expressed as a pattern.
+var (
+	addressPattern    = `S[PMTN][0-9A-HJKMNP-TV-Z]{26,40}`
+	contractPattern   = fmt.Sprintf(`%s\.[a-zA-Z][a-zA-Z0-9_-]{%d,%d}`, addressPattern, stacks.ContractNameMinLength-1, stacks.ContractNameMaxLength-1)
+	clarityNameSchema = fmt.Sprintf(`[a-zA-Z][a-zA-Z0-9_!?+<>=/*-]{0,%d}`, maxClarityNameLength-1)
+)
+
+// schemaEnums lists the values of the string types that are enums.
+var schemaEnums = map[reflect.Type][]string{
+	reflect.TypeOf(Network("")): {string(NetworkMainnet), string(NetworkTestnet)},
+	reflect.TypeOf(Chain("")):   {string(ChainStacks)},
+	reflect.TypeOf(ChainhookStatus("")): {
+		string(ChainhookStatusNew),
+		string(ChainhookStatusStreaming),
+		string(ChainhookStatusExpired),
+		string(ChainhookStatusInterrupted),
+	},
+	reflect.TypeOf(EventType("")): schemaEventTypes(),
+}
+
+// schemaFieldOverrides holds schemas for fields whose Go type is too general,
+// keyed by "<struct>.<json field>".
+var schemaFieldOverrides = map[string]map[string]interface{}{
+	"ChainhookAction.type":                      {"enum": []string{ActionTypeHTTPPost}},
+	"ChainhookAction.url":                       {"type": "string", "format": "uri", "pattern": "^https?://"},
+	"ChainhookOptions.expire_after_evaluations": {"type": "integer", "minimum": 1},
+	"ChainhookOptions.expire_after_occurrences": {"type": "integer", "minimum": 1},
+	"ContractCallFilter.contract_identifier":    {"$ref": "#/$defs/ContractIdentifier"},
+	"ContractCallFilter.method":                 {"type": "string", "pattern": "^" + clarityNameSchema + "$"},
+	"ContractLogFilter.contract_identifier":     {"$ref": "#/$defs/ContractIdentifier"},
+	"FTEventFilter.action":                      {"enum": []string{"", FilterActionMint, FilterActionBurn, FilterActionTransfer}},
+	"NFTEventFilter.action":                     {"enum": []string{"", FilterActionMint, FilterActionBurn, FilterActionTransfer}},
+	"STXEventFilter.action":                     {"enum": []string{"", FilterActionMint, FilterActionBurn, FilterActionTransfer, FilterActionLock}},
+}
+
+var (
+	eventFilterType     = reflect.TypeOf((*EventFilter)(nil)).Elem()
+	principalType       = reflect.TypeOf(Principal{})
+	assetIdentifierType = reflect.TypeOf(AssetIdentifier(""))
+	amountType          = reflect.TypeOf(Amount{})
+)
+
+// JSONSchema generates a JSON Schema (draft 2020-12) for one of the
+// library's types, such as ChainhookDefinition{} or Chainhook{}. Each
+// EventFilter is a oneOf of the filter structs discriminated by "type";
+// enum types list their values; and principals, contract identifiers and
+// asset identifiers carry patterns.
+//
+// Webhook payloads have no schema yet. Schemas are generated from Go types,
+// and the library does not define types for payloads; see the README.
+func JSONSchema(v interface{}) ([]byte, error) {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil, fmt.Errorf("cannot generate a schema for nil")
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	g := &schemaGenerator{defs: make(map[string]interface{})}
+	root, err := g.schema(t)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := map[string]interface{}{
+		"$schema": JSONSchemaDialect,
+		"title":   t.Name(),
+		"$defs":   g.defs,
+	}
+	for k, v := range root {
+		doc[k] = v
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// schemaGenerator collects the definitions referenced by a schema.
+type schemaGenerator struct {
+	defs map[string]interface{}
+}
+
+// ref returns a reference to the named definition, building it first if
+// needed.
+func (g *schemaGenerator) ref(name string, build func() (map[string]interface{}, error)) (map[string]interface{}, error) {
+	if _, ok := g.defs[name]; !ok {
+		// Reserve the name so recursive types terminate.
+		g.defs[name] = nil
+		def, err := build()
+		if err != nil {
+			return nil, err
+		}
+		g.defs[name] = def
+	}
+	return map[string]interface{}{"$ref": "#/$defs/" + name}, nil
+}
+
+func (g *schemaGenerator) schema(t reflect.Type) (map[string]interface{}, error) {
+	if values, ok := schemaEnums[t]; ok {
+		return g.ref(t.Name(), func() (map[string]interface{}, error) {
+			return map[string]interface{}{"type": "string", "enum": values}, nil
+		})
+	}
+
+	switch t {
+	case eventFilterType:
+		return g.ref("EventFilter", g.eventFilter)
+	case principalType:
+		return g.ref("Principal", g.principal)
+	case assetIdentifierType:
+		return g.ref("AssetIdentifier", func() (map[string]interface{}, error) {
+			return map[string]interface{}{
+				"type":        "string",
+				"description": "An asset identifier of the form <address>.<contract-name>::<asset-name>.",
+				"pattern":     "^" + contractPattern + "::" + clarityNameSchema + "$",
+			}, nil
+		})
+	case amountType:
+		return g.ref("Amount", func() (map[string]interface{}, error) {
+			return map[string]interface{}{
+				"description": "An unsigned integer amount, encoded as a decimal string.",
+				"anyOf": []interface{}{
+					map[string]interface{}{"type": "string", "pattern": "^[0-9]+$"},
+					map[string]interface{}{"type": "integer", "minimum": 0},
+				},
+			}, nil
+		})
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return g.schema(t.Elem())
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}, nil
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]interface{}{"type": "integer"}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "minimum": 0}, nil
+	case reflect.Slice:
+		items, err := g.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"type": "array", "items": items}, nil
+	case reflect.Struct:
+		return g.ref(t.Name(), func() (map[string]interface{}, error) {
+			return g.object(t)
+		})
+	default:
+		return nil, fmt.Errorf("cannot generate a schema for %s", t)
+	}
+}
+
+// object builds the schema of a struct from its JSON field tags. Fields
+// without omitempty are required, and pointer fields without omitempty
+// may be null.
+func (g *schemaGenerator) object(t reflect.Type) (map[string]interface{}, error) {
+	properties := make(map[string]interface{})
+	required := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		name, opts, _ := strings.Cut(tag, ",")
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		omitempty := strings.Contains(opts, "omitempty")
+
+		var prop map[string]interface{}
+		if override, ok := schemaFieldOverrides[t.Name()+"."+name]; ok {
+			g.identifiers()
+			prop = override
+		} else {
+			var err error
+			prop, err = g.schema(field.Type)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", t.Name(), field.Name, err)
+			}
+		}
+		if field.Type.Kind() == reflect.Ptr && !omitempty {
+			prop = map[string]interface{}{"anyOf": []interface{}{prop, map[string]interface{}{"type": "null"}}}
+		}
+
+		properties[name] = prop
+		if !omitempty {
+			required = append(required, name)
+		}
+	}
+
+	return map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}, nil
+}
+
+// eventFilter builds a oneOf of every filter struct, each with its event type
+// as the constant value of "type", and of RawEventFilter for the other event
+// types.
+//
+// Filters accept properties beyond those of their struct, and filters of
+// unknown type are accepted, because DecodeEventFilter keeps such filters as
+// a RawEventFilter rather than rejecting them.
+func (g *schemaGenerator) eventFilter() (map[string]interface{}, error) {
+	var variants []interface{}
+	eventTypes := schemaEventTypes()
+	for _, eventType := range eventTypes {
+		t := reflect.TypeOf(eventFilterFactories[EventType(eventType)]()).Elem()
+		if _, err := g.schema(t); err != nil {
+			return nil, err
+		}
+		def := g.defs[t.Name()].(map[string]interface{})
+		def["properties"].(map[string]interface{})["type"] = map[string]interface{}{"const": eventType}
+		delete(def, "additionalProperties")
+		variants = append(variants, map[string]interface{}{"$ref": "#/$defs/" + t.Name()})
+	}
+
+	g.defs["RawEventFilter"] = map[string]interface{}{
+		"type":        "object",
+		"description": "A filter of an event type unknown to this client version, kept as received.",
+		"properties": map[string]interface{}{
+			"type": map[string]interface{}{"type": "string", "not": map[string]interface{}{"enum": eventTypes}},
+		},
+		"required": []string{"type"},
+	}
+	variants = append(variants, map[string]interface{}{"$ref": "#/$defs/RawEventFilter"})
+	return map[string]interface{}{"oneOf": variants}, nil
+}
+
+// principal builds the schema of a principal, which sets exactly one of
+// standard or contract.
+func (g *schemaGenerator) principal() (map[string]interface{}, error) {
+	g.identifiers()
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"standard": map[string]interface{}{"$ref": "#/$defs/StandardPrincipal"},
+			"contract": map[string]interface{}{"$ref": "#/$defs/ContractIdentifier"},
+		},
+		"oneOf": []interface{}{
+			map[string]interface{}{"required": []string{"standard"}},
+			map[string]interface{}{"required": []string{"contract"}},
+		},
+		"additionalProperties": false,
+	}, nil
+}
+
+// identifiers adds the definitions of addresses and contract identifiers,
+// which principals and field overrides refer to.
+func (g *schemaGenerator) identifiers() {
+	g.defs["StandardPrincipal"] = map[string]interface{}{
+		"type":        "string",
+		"description": "A Stacks address, such as SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7.",
+		"pattern":     "^" + addressPattern + "$",
+	}
+	g.defs["ContractIdentifier"] = map[string]interface{}{
+		"type":        "string",
+		"description": "A contract identifier of the form <address>.<contract-name>.",
+		"pattern":     "^" + contractPattern + "$",
+	}
+}
+
+// schemaEventTypes returns every event type with a filter struct, sorted.
+func schemaEventTypes() []string {
+	types := make([]string, 0, len(eventFilterFactories))
+	for eventType := range eventFilterFactories {
+		types = append(types, string(eventType))
+	}
+	sort.Strings(types)
+	return types
+}
@@ -0,0 +1,166 @@
+package chainhooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"testing"
+)
+
+var updateSchemas = flag.Bool("update-schemas", false, "rewrite the embedded schema files")
+
+func TestEmbeddedSchemas(t *testing.T) {
+	for file, v := range schemaFiles {
+		generated, err := JSONSchema(v)
+		if err != nil {
+			t.Fatalf("%s: %v", file, err)
+		}
+		generated = append(generated, '\n')
+
+		path := filepath.Join("schema", file)
+		if *updateSchemas {
+			if err := os.WriteFile(path, generated, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		embedded, err := SchemaFiles.ReadFile("schema/" + file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(embedded, generated) {
+			t.Errorf("%s is out of date; run go generate", path)
+		}
+	}
+}
+
+// schemaDoc decodes the definition schema for inspection.
+func schemaDoc(t *testing.T) map[string]interface{} {
+	t.Helper()
+	data, err := JSONSchema(&ChainhookDefinition{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestJSONSchemaEventFilters(t *testing.T) {
+	defs := schemaDoc(t)["$defs"].(map[string]interface{})
+	variants := defs["EventFilter"].(map[string]interface{})["oneOf"].([]interface{})
+	if len(variants) != len(eventFilterFactories)+1 {
+		t.Fatalf("got %d filter variants, want %d and RawEventFilter", len(variants), len(eventFilterFactories))
+	}
+
+	// Each filter schema lists exactly the fields the filter marshals, with
+	// its event type as the constant "type".
+	for eventType, factory := range eventFilterFactories {
+		filter := factory()
+		data, _ := json.Marshal(filter)
+		var encoded map[string]interface{}
+		json.Unmarshal(data, &encoded)
+
+		name := reflect.TypeOf(filter).Elem().Name()
+		def, ok := defs[name].(map[string]interface{})
+		if !ok {
+			t.Fatalf("no schema for %s", name)
+		}
+		properties := def["properties"].(map[string]interface{})
+		if got := properties["type"].(map[string]interface{})["const"]; got != string(eventType) {
+			t.Errorf("%s: type const %v, want %s", name, got, eventType)
+		}
+		// Unknown fields are kept in a RawEventFilter, so they are allowed
+		if _, ok := def["additionalProperties"]; ok {
+			t.Errorf("%s: additional properties are restricted", name)
+		}
+		for field := range encoded {
+			if _, ok := properties[field]; !ok {
+				t.Errorf("%s: marshaled field %q missing from schema", name, field)
+			}
+		}
+		for _, field := range def["required"].([]interface{}) {
+			if _, ok := encoded[field.(string)]; !ok {
+				t.Errorf("%s: required field %q not marshaled", name, field)
+			}
+		}
+	}
+}
+
+func TestJSONSchemaEnums(t *testing.T) {
+	defs := schemaDoc(t)["$defs"].(map[string]interface{})
+	enum := func(name string) []string {
+		var values []string
+		for _, v := range defs[name].(map[string]interface{})["enum"].([]interface{}) {
+			values = append(values, v.(string))
+		}
+		return values
+	}
+
+	if got := enum("Network"); len(got) != 2 {
+		t.Errorf("unexpected Network enum %v", got)
+	}
+	eventTypes := enum("EventType")
+	if !sort.StringsAreSorted(eventTypes) || len(eventTypes) != len(eventFilterFactories) {
+		t.Errorf("unexpected EventType enum %v", eventTypes)
+	}
+
+	data, _ := JSONSchema(Chainhook{})
+	var doc map[string]interface{}
+	json.Unmarshal(data, &doc)
+	status := doc["$defs"].(map[string]interface{})["ChainhookStatus"].(map[string]interface{})
+	if len(status["enum"].([]interface{})) != 4 {
+		t.Errorf("unexpected ChainhookStatus enum %v", status["enum"])
+	}
+}
+
+func TestJSONSchemaPatterns(t *testing.T) {
+	defs := schemaDoc(t)["$defs"].(map[string]interface{})
+	pattern := func(name string) *regexp.Regexp {
+		return regexp.MustCompile(defs[name].(map[string]interface{})["pattern"].(string))
+	}
+
+	tests := []struct {
+		def   string
+		value string
+		want  bool
+	}{
+		{"StandardPrincipal", "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7", true},
+		{"StandardPrincipal", testAddress, true},
+		{"StandardPrincipal", "ST2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7.pool", false},
+		{"StandardPrincipal", "0x1234", false},
+		{"StandardPrincipal", "Sp2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7", false},
+		{"StandardPrincipal", "SP2j6zy48gv1ez5v2v5rb9mp66sw86pykknrv9ej7", false},
+		{"StandardPrincipal", "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJO", false},
+		{"StandardPrincipal", "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJI", false},
+		{"StandardPrincipal", "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJL", false},
+		{"StandardPrincipal", "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJU", false},
+		{"ContractIdentifier", "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7.pool-v2", true},
+		{"ContractIdentifier", "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7", false},
+		{"ContractIdentifier", "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7.2pool", false},
+		{"AssetIdentifier", "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7.token::tkn", true},
+		{"AssetIdentifier", "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7.token", false},
+		{"AssetIdentifier", "USDA", false},
+	}
+	for _, tt := range tests {
+		if got := pattern(tt.def).MatchString(tt.value); got != tt.want {
+			t.Errorf("%s %q: got %v, want %v", tt.def, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestJSONSchemaErrors(t *testing.T) {
+	if _, err := JSONSchema(nil); err == nil {
+		t.Error("expected error for nil")
+	}
+	if _, err := JSONSchema(map[string]string{}); err == nil {
+		t.Error("expected error for unsupported type")
+	}
+}
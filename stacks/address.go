@@ -0,0 +1,119 @@
+package stacks
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Address versions.
+const (
+	AddressVersionMainnetSingleSig byte = 22 // SP...
+	AddressVersionMainnetMultiSig  byte = 20 // SM...
+	AddressVersionTestnetSingleSig byte = 26 // ST...
+	AddressVersionTestnetMultiSig  byte = 21 // SN...
+)
+
+// Contract name length limits enforced by Clarity.
+const (
+	ContractNameMinLength = 1
+	ContractNameMaxLength = 40
+)
+
+// contractNamePattern matches a Clarity contract name.
+var contractNamePattern = regexp.MustCompile(`^[a-zA-Z]([a-zA-Z0-9]|[-_])*$`)
+
+// ErrNonCanonical is returned when an address decodes correctly but is not
+// written in its canonical upper-case form.
+var ErrNonCanonical = errors.New("address is not in canonical form")
+
+// Address is a decoded Stacks address.
+type Address struct {
+	Version byte
+	Hash160 [20]byte
+}
+
+// NewAddress creates an address from a version and a 20-byte hash.
+func NewAddress(version byte, hash160 [20]byte) Address {
+	return Address{Version: version, Hash160: hash160}
+}
+
+// ParseAddress decodes a Stacks address such as
+// "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7", verifying its checksum,
+// version and canonical encoding.
+func ParseAddress(s string) (Address, error) {
+	if !strings.HasPrefix(s, "S") {
+		return Address{}, fmt.Errorf("address %q must start with 'S'", s)
+	}
+
+	version, data, err := C32CheckDecode(s[1:])
+	if err != nil {
+		return Address{}, fmt.Errorf("address %q: %w", s, err)
+	}
+	if len(data) != 20 {
+		return Address{}, fmt.Errorf("address %q: hash must be 20 bytes, got %d", s, len(data))
+	}
+
+	var addr Address
+	addr.Version = version
+	copy(addr.Hash160[:], data)
+
+	if !addr.IsMainnet() && !addr.IsTestnet() {
+		return Address{}, fmt.Errorf("address %q: %w %d", s, ErrInvalidVersion, version)
+	}
+	if addr.String() != s {
+		return Address{}, fmt.Errorf("address %q: %w, expected %q", s, ErrNonCanonical, addr.String())
+	}
+
+	return addr, nil
+}
+
+// String returns the c32check encoding of the address.
+func (a Address) String() string {
+	s, err := C32CheckEncode(a.Version, a.Hash160[:])
+	if err != nil {
+		return ""
+	}
+	return "S" + s
+}
+
+// IsMainnet reports whether the address has a mainnet version (SP or SM).
+func (a Address) IsMainnet() bool {
+	return a.Version == AddressVersionMainnetSingleSig || a.Version == AddressVersionMainnetMultiSig
+}
+
+// IsTestnet reports whether the address has a testnet version (ST or SN).
+func (a Address) IsTestnet() bool {
+	return a.Version == AddressVersionTestnetSingleSig || a.Version == AddressVersionTestnetMultiSig
+}
+
+// ValidateContractName checks a contract name against the Clarity naming rules.
+func ValidateContractName(name string) error {
+	if len(name) < ContractNameMinLength || len(name) > ContractNameMaxLength {
+		return fmt.Errorf("contract name %q must be between %d and %d characters", name, ContractNameMinLength, ContractNameMaxLength)
+	}
+	if !contractNamePattern.MatchString(name) {
+		return fmt.Errorf("contract name %q must start with a letter and contain only letters, digits, '-' and '_'", name)
+	}
+	return nil
+}
+
+// ParseContractIdentifier splits and validates a contract identifier of the
+// form "<address>.<contract-name>".
+func ParseContractIdentifier(id string) (Address, string, error) {
+	address, name, ok := strings.Cut(id, ".")
+	if !ok {
+		return Address{}, "", fmt.Errorf("contract identifier %q must have the form <address>.<contract-name>", id)
+	}
+
+	addr, err := ParseAddress(address)
+	if err != nil {
+		return Address{}, "", fmt.Errorf("contract identifier %q: %w", id, err)
+	}
+	if err := ValidateContractName(name); err != nil {
+		return Address{}, "", fmt.Errorf("contract identifier %q: %w", id, err)
+	}
+
+	return addr, name, nil
+}
@@ -0,0 +1,63 @@
+package stacks
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestAddressRoundTrip(t *testing.T) {
+	tests := []struct {
+		version byte
+		hash    string
+		address string
+	}{
+		{AddressVersionMainnetSingleSig, "a46ff88886c2ef9762d970b4d2c63678835bd39d", "SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ7"},
+		{AddressVersionMainnetSingleSig, "0000000000000000000000000000000000000000", "SP000000000000000000002Q6VF78"},
+		{AddressVersionTestnetSingleSig, "0000000000000000000000000000000000000000", "ST000000000000000000002AMW42H"},
+	}
+
+	for _, tt := range tests {
+		raw, _ := hex.DecodeString(tt.hash)
+		var hash [20]byte
+		copy(hash[:], raw)
+
+		if got := NewAddress(tt.version, hash).String(); got != tt.address {
+			t.Errorf("encode %s: got %s, want %s", tt.hash, got, tt.address)
+		}
+
+		addr, err := ParseAddress(tt.address)
+		if err != nil {
+			t.Errorf("parse %s: %v", tt.address, err)
+			continue
+		}
+		if addr.Version != tt.version || hex.EncodeToString(addr.Hash160[:]) != tt.hash {
+			t.Errorf("parse %s: got version %d hash %x", tt.address, addr.Version, addr.Hash160)
+		}
+	}
+}
+
+func TestParseAddressRejectsInvalid(t *testing.T) {
+	if _, err := ParseAddress("SP2J6ZY48GV1EZ5V2V5RB9MP66SW86PYKKNRV9EJ8"); !errors.Is(err, ErrInvalidChecksum) {
+		t.Errorf("expected checksum error, got %v", err)
+	}
+	if _, err := ParseAddress("sp2j6zy48gv1ez5v2v5rb9mp66sw86pykknrv9ej7"); err == nil {
+		t.Error("expected an error for a lower-case address")
+	}
+	if _, err := ParseAddress("SP..."); err == nil {
+		t.Error("expected an error for a placeholder address")
+	}
+}
+
+func TestValidateContractName(t *testing.T) {
+	for _, name := range []string{"pool", "token-v2", "my_contract", "A1"} {
+		if err := ValidateContractName(name); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "1token", "token.v2", "name-that-is-far-too-long-for-a-clarity-contract"} {
+		if err := ValidateContractName(name); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Package stacks implements Stacks address encoding and naming rules.
+//
+// Addresses are encoded with c32check: a version character followed by the
+// Crockford base32 encoding of a 20-byte hash and a 4-byte double-SHA256
+// checksum, prefixed with "S".
+package stacks
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// c32Alphabet is the Crockford base32 alphabet used by c32 encoding.
+const c32Alphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// checksumLength is the length of a c32check checksum in bytes.
+const checksumLength = 4
+
+var (
+	// ErrInvalidCharacter is returned when a string contains a character
+	// outside the c32 alphabet.
+	ErrInvalidCharacter = errors.New("invalid c32 character")
+	// ErrInvalidChecksum is returned when a c32check checksum does not match.
+	ErrInvalidChecksum = errors.New("invalid c32check checksum")
+	// ErrInvalidVersion is returned when a version does not fit in 5 bits.
+	ErrInvalidVersion = errors.New("invalid c32check version")
+)
+
+// C32Encode encodes data with the c32 alphabet. Each leading zero byte is
+// encoded as a leading '0' character.
+func C32Encode(data []byte) string {
+	var sb strings.Builder
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		sb.WriteByte('0')
+	}
+
+	n := new(big.Int).SetBytes(data)
+	if n.Sign() == 0 {
+		return sb.String()
+	}
+
+	var digits []byte
+	base := big.NewInt(32)
+	mod := new(big.Int)
+	for n.Sign() > 0 {
+		n.DivMod(n, base, mod)
+		digits = append(digits, c32Alphabet[mod.Int64()])
+	}
+	for i := len(digits) - 1; i >= 0; i-- {
+		sb.WriteByte(digits[i])
+	}
+	return sb.String()
+}
+
+// C32Decode decodes a c32 string. Decoding is case-insensitive and accepts
+// the Crockford substitutions O for 0 and I or L for 1.
+func C32Decode(s string) ([]byte, error) {
+	s = normalizeC32(s)
+
+	var zeros int
+	for zeros < len(s) && s[zeros] == '0' {
+		zeros++
+	}
+
+	n := new(big.Int)
+	base := big.NewInt(32)
+	for i := zeros; i < len(s); i++ {
+		digit := strings.IndexByte(c32Alphabet, s[i])
+		if digit < 0 {
+			return nil, fmt.Errorf("%w %q", ErrInvalidCharacter, s[i])
+		}
+		n.Mul(n, base)
+		n.Add(n, big.NewInt(int64(digit)))
+	}
+
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
+
+// C32CheckEncode encodes data with a version and checksum.
+func C32CheckEncode(version byte, data []byte) (string, error) {
+	if int(version) >= len(c32Alphabet) {
+		return "", fmt.Errorf("%w %d", ErrInvalidVersion, version)
+	}
+
+	payload := append(append([]byte(nil), data...), checksum(version, data)...)
+	return string(c32Alphabet[version]) + C32Encode(payload), nil
+}
+
+// C32CheckDecode decodes a c32check string into its version and data,
+// verifying the checksum.
+func C32CheckDecode(s string) (version byte, data []byte, err error) {
+	s = normalizeC32(s)
+	if len(s) < 2 {
+		return 0, nil, fmt.Errorf("c32check string %q is too short", s)
+	}
+
+	v := strings.IndexByte(c32Alphabet, s[0])
+	if v < 0 {
+		return 0, nil, fmt.Errorf("%w %q", ErrInvalidCharacter, s[0])
+	}
+
+	payload, err := C32Decode(s[1:])
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(payload) < checksumLength {
+		return 0, nil, fmt.Errorf("c32check string %q is too short", s)
+	}
+
+	data = payload[:len(payload)-checksumLength]
+	sum := payload[len(payload)-checksumLength:]
+	if string(sum) != string(checksum(byte(v), data)) {
+		return 0, nil, ErrInvalidChecksum
+	}
+
+	return byte(v), data, nil
+}
+
+// checksum returns the first 4 bytes of the double SHA-256 of version and data.
+func checksum(version byte, data []byte) []byte {
+	first := sha256.Sum256(append([]byte{version}, data...))
+	second := sha256.Sum256(first[:])
+	return second[:checksumLength]
+}
+
+// normalizeC32 upper-cases s and applies the Crockford substitutions.
+func normalizeC32(s string) string {
+	return strings.NewReplacer("O", "0", "L", "1", "I", "1").Replace(strings.ToUpper(s))
+}
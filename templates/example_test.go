@@ -0,0 +1,56 @@
+package templates_test
+
+import (
+	"fmt"
+
+	chainhooks "github.com/tony1908/chainhooks-client-go"
+	"github.com/tony1908/chainhooks-client-go/templates"
+)
+
+// ExampleAllSTXTransfers demonstrates a hook for every STX transfer on the network.
+func ExampleAllSTXTransfers() {
+	definition := templates.AllSTXTransfers("stx-transfers", chainhooks.NetworkMainnet).
+		WithWebhookURL("https://example.com/webhook").
+		MustBuild()
+
+	text, _ := chainhooks.FormatFilterExpression(definition.Filters.Events)
+	fmt.Println(text)
+	// Output:
+	// stx_transfer()
+}
+
+// ExampleSIP010TokenActivity demonstrates a hook for all activity on a fungible token.
+func ExampleSIP010TokenActivity() {
+	definition := templates.SIP010TokenActivity("token-activity", chainhooks.NetworkMainnet, "SP000000000000000000002Q6VF78.token::tkn").
+		WithWebhookURL("https://example.com/webhook").
+		MustBuild()
+
+	text, _ := chainhooks.FormatFilterExpression(definition.Filters.Events)
+	fmt.Println(text)
+	// Output:
+	// ft_mint(asset=SP000000000000000000002Q6VF78.token::tkn) or ft_burn(asset=SP000000000000000000002Q6VF78.token::tkn) or ft_transfer(asset=SP000000000000000000002Q6VF78.token::tkn)
+}
+
+// ExampleBalanceChangeWatchlist demonstrates a hook for balance changes of several principals.
+func ExampleBalanceChangeWatchlist() {
+	definition := templates.BalanceChangeWatchlist("watchlist", chainhooks.NetworkTestnet,
+		"ST000000000000000000002AMW42H",
+		"ST000000000000000000002AMW42H.vault",
+	).WithWebhookURL("https://example.com/webhook").MustBuild()
+
+	text, _ := chainhooks.FormatFilterExpression(definition.Filters.Events)
+	fmt.Println(text)
+	// Output:
+	// balance_change(principal=ST000000000000000000002AMW42H) or balance_change(principal=ST000000000000000000002AMW42H.vault)
+}
+
+// ExampleContractPrintLogs demonstrates a hook for print events with decoded Clarity values.
+func ExampleContractPrintLogs() {
+	definition := templates.ContractPrintLogs("pool-logs", chainhooks.NetworkMainnet, "SP000000000000000000002Q6VF78.pool").
+		WithWebhookURL("https://example.com/webhook").
+		MustBuild()
+
+	fmt.Println(definition.Filters.Events[0].EventType(), *definition.Options.DecodeClarityValues)
+	// Output:
+	// contract_log true
+}
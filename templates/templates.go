@@ -0,0 +1,93 @@
+// Package templates provides ready-made chainhook builders for common Stacks
+// monitoring patterns.
+//
+// Each template returns a *chainhooks.ChainhookBuilder with its filters and
+// options set, so the caller only adds a webhook URL, adjusts anything else
+// and builds:
+//
+//	definition, err := templates.ContractPrintLogs("pool-logs", chainhooks.NetworkMainnet, "SP...pool").
+//		WithWebhookURL("https://example.com/webhook").
+//		Build()
+//
+// Every template enables the chainhook on registration. Invalid arguments
+// are reported by Build like any other builder error.
+package templates
+
+import (
+	"strings"
+
+	chainhooks "github.com/tony1908/chainhooks-client-go"
+)
+
+// newBuilder returns a builder with the options shared by every template.
+func newBuilder(name string, network chainhooks.Network) *chainhooks.ChainhookBuilder {
+	return chainhooks.NewChainhookBuilder(name, network).
+		WithEnableOnRegistration(true)
+}
+
+// principal returns a contract principal for identifiers containing a '.'
+// and a standard principal otherwise.
+func principal(s string) *chainhooks.Principal {
+	if strings.Contains(s, ".") {
+		return chainhooks.PrincipalContract(s)
+	}
+	return chainhooks.PrincipalStandard(s)
+}
+
+// AllSTXTransfers matches every STX transfer on the network, between any
+// sender and recipient.
+//
+// There is no template for transfers above a threshold: the amount filter
+// of the Chainhooks API matches one exact amount, so large transfers cannot
+// be selected server-side. Register this hook and compare the amount of each
+// delivered transfer, for example with Amount.Cmp, to watch for them.
+func AllSTXTransfers(name string, network chainhooks.Network) *chainhooks.ChainhookBuilder {
+	return newBuilder(name, network).
+		AddSTXTransfer(nil, nil, nil)
+}
+
+// SIP010TokenActivity matches every mint, burn and transfer of a SIP-010
+// fungible token.
+func SIP010TokenActivity(name string, network chainhooks.Network, asset chainhooks.AssetIdentifier) *chainhooks.ChainhookBuilder {
+	return newBuilder(name, network).
+		AddFTMint(asset, nil, nil).
+		AddFTBurn(asset, nil, nil).
+		AddFTTransfer(asset, nil, nil, nil)
+}
+
+// SIP009CollectionActivity matches every mint, burn and transfer in a SIP-009
+// NFT collection.
+func SIP009CollectionActivity(name string, network chainhooks.Network, asset chainhooks.AssetIdentifier) *chainhooks.ChainhookBuilder {
+	return newBuilder(name, network).
+		AddNFTMint(asset, nil).
+		AddNFTBurn(asset, nil).
+		AddNFTTransfer(asset, nil, nil)
+}
+
+// ContractDeploymentsBy matches contract deployments by deployer, a
+// standard or contract principal. Deliveries include the contract ABI and
+// source code, which is affordable because the filter is narrow.
+func ContractDeploymentsBy(name string, network chainhooks.Network, deployer string) *chainhooks.ChainhookBuilder {
+	return newBuilder(name, network).
+		AddContractDeploy(principal(deployer)).
+		WithIncludeContractABI(true).
+		WithIncludeContractSourceCode(true)
+}
+
+// BalanceChangeWatchlist matches balance changes of every principal in the
+// watchlist. Principals containing a '.' are contract principals.
+func BalanceChangeWatchlist(name string, network chainhooks.Network, principals ...string) *chainhooks.ChainhookBuilder {
+	b := newBuilder(name, network)
+	for _, p := range principals {
+		b.AddBalanceChange(principal(p))
+	}
+	return b
+}
+
+// ContractPrintLogs matches print events emitted by a contract. Clarity
+// values are decoded so that the printed values are readable in deliveries.
+func ContractPrintLogs(name string, network chainhooks.Network, contractID string) *chainhooks.ChainhookBuilder {
+	return newBuilder(name, network).
+		AddContractLog(&contractID).
+		WithDecodeClarityValues(true)
+}
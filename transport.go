@@ -0,0 +1,98 @@
+package chainhooks
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Dialer establishes network connections for the client's HTTP transport.
+//
+// *net.Dialer satisfies this interface.
+type Dialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// unixScheme is the BaseURL scheme for Chainhooks APIs reached through a Unix
+// domain socket.
+const unixScheme = "unix://"
+
+// unixHost is the placeholder host used in request URLs sent over a Unix
+// domain socket.
+const unixHost = "unix"
+
+// parseUnixBaseURL splits a unix:// base URL into the socket path and the
+// HTTP path prefix. The prefix, if any, follows the socket path after a
+// colon, e.g. "unix:///run/sidecar.sock:/hiro".
+func parseUnixBaseURL(baseURL string) (socketPath, prefix string, err error) {
+	rest := strings.TrimPrefix(baseURL, unixScheme)
+	if !strings.HasPrefix(rest, "/") {
+		return "", "", &ConfigError{
+			Message: "unix base URL must use an absolute socket path (unix:///path/to/socket)",
+		}
+	}
+
+	socketPath = rest
+	if i := strings.Index(rest, ":"); i >= 0 {
+		socketPath, prefix = rest[:i], rest[i+1:]
+		if prefix != "" && !strings.HasPrefix(prefix, "/") {
+			return "", "", &ConfigError{
+				Message: "unix base URL path prefix must start with '/'",
+			}
+		}
+	}
+
+	return socketPath, strings.TrimSuffix(prefix, "/"), nil
+}
+
+// configureTransport returns an HTTP client whose transport dials through
+// dialer and, when socketPath is set, always connects to that Unix socket.
+// The given client is not modified.
+func configureTransport(httpClient *http.Client, dialer Dialer, socketPath string) (*http.Client, error) {
+	if dialer == nil && socketPath == "" {
+		return httpClient, nil
+	}
+
+	var transport *http.Transport
+	switch t := httpClient.Transport.(type) {
+	case nil:
+		if def, ok := http.DefaultTransport.(*http.Transport); ok {
+			transport = def.Clone()
+		} else {
+			// http.DefaultTransport was replaced; start from its stock settings
+			transport = &http.Transport{
+				Proxy:                 http.ProxyFromEnvironment,
+				ForceAttemptHTTP2:     true,
+				MaxIdleConns:          100,
+				IdleConnTimeout:       90 * time.Second,
+				TLSHandshakeTimeout:   10 * time.Second,
+				ExpectContinueTimeout: 1 * time.Second,
+			}
+		}
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return nil, &ConfigError{
+			Message: "Dialer and unix base URLs require the HTTP client to use an *http.Transport",
+		}
+	}
+
+	if dialer == nil {
+		dialer = &net.Dialer{}
+	}
+
+	if socketPath != "" {
+		transport.Proxy = nil
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "unix", socketPath)
+		}
+	} else {
+		transport.DialContext = dialer.DialContext
+	}
+
+	configured := *httpClient
+	configured.Transport = transport
+	return &configured, nil
+}
@@ -0,0 +1,97 @@
+package chainhooks
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+)
+
+func TestUnixSocketBaseURL(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "sidecar.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Skipf("unix sockets unavailable: %v", err)
+	}
+
+	var gotPath string
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Write([]byte(`{"uuid":"abc"}`))
+	})}
+	go server.Serve(listener)
+	defer server.Close()
+
+	client := NewClientWithConfig(&ClientConfig{
+		BaseURL: "unix://" + socketPath + ":/hiro/",
+	})
+
+	hook, err := client.GetChainhook(context.Background(), "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hook.UUID != "abc" {
+		t.Fatalf("unexpected uuid %q", hook.UUID)
+	}
+	if gotPath != "/hiro/chainhooks/me/abc" {
+		t.Fatalf("unexpected request path %q", gotPath)
+	}
+}
+
+func TestUnixSocketBaseURLRequiresAbsolutePath(t *testing.T) {
+	client := NewClientWithConfig(&ClientConfig{
+		BaseURL: "unix://relative.sock",
+	})
+
+	_, err := client.GetStatus(context.Background())
+	var configErr *ConfigError
+	if !errors.As(err, &configErr) {
+		t.Fatalf("expected ConfigError, got %v", err)
+	}
+}
+
+func TestParseUnixBaseURL(t *testing.T) {
+	tests := []struct {
+		baseURL    string
+		socketPath string
+		prefix     string
+	}{
+		{"unix:///run/sidecar.sock", "/run/sidecar.sock", ""},
+		{"unix:///run/sidecar.sock:/", "/run/sidecar.sock", ""},
+		{"unix:///run/sidecar.sock:/api/v1", "/run/sidecar.sock", "/api/v1"},
+	}
+
+	for _, tt := range tests {
+		socketPath, prefix, err := parseUnixBaseURL(tt.baseURL)
+		if err != nil {
+			t.Errorf("%s: %v", tt.baseURL, err)
+			continue
+		}
+		if socketPath != tt.socketPath || prefix != tt.prefix {
+			t.Errorf("%s: got (%q, %q), want (%q, %q)", tt.baseURL, socketPath, prefix, tt.socketPath, tt.prefix)
+		}
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestConfigureTransportWithReplacedDefaultTransport(t *testing.T) {
+	original := http.DefaultTransport
+	http.DefaultTransport = roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("unused")
+	})
+	defer func() { http.DefaultTransport = original }()
+
+	httpClient, err := configureTransport(&http.Client{}, &net.Dialer{}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport, ok := httpClient.Transport.(*http.Transport)
+	if !ok || transport.DialContext == nil {
+		t.Fatalf("expected a transport dialing through the dialer, got %T", httpClient.Transport)
+	}
+}
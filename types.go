@@ -80,57 +80,82 @@ const (
 // ============================================================================
 
 // EventFilter is an interface for all event filter types.
+//
+// Filters are always marshaled with the "type" of their own struct, so the
+// Type field of a filter struct cannot contradict it.
+//
+// The Amount field of the FT and STX filters matches events of exactly that
+// amount; it is not a minimum. A filter without an Amount matches events of
+// any amount.
 type EventFilter interface {
+	// EventType returns the event type matched by the filter.
+	EventType() EventType
 	eventFilterMarker()
 }
 
 // FTEventFilter represents a fungible token event filter.
 type FTEventFilter struct {
 	Type            EventType `json:"type"`
-	Asset           string    `json:"asset"`
+	Asset           AssetIdentifier `json:"asset"`
 	Action          string    `json:"action"`
 	Sender          *Principal `json:"sender,omitempty"`
 	Receiver        *Principal `json:"receiver,omitempty"`
-	Amount          *string   `json:"amount,omitempty"`
+	// Amount matches events of exactly this amount; see EventFilter.
+	Amount          *Amount   `json:"amount,omitempty"`
 }
 
 func (f *FTEventFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *FTEventFilter) EventType() EventType { return EventTypeFTEvent }
+
 // FTMintFilter represents a fungible token mint event filter.
 type FTMintFilter struct {
 	Type            EventType `json:"type"`
-	Asset           string    `json:"asset"`
+	Asset           AssetIdentifier `json:"asset"`
 	Recipient       *Principal `json:"recipient,omitempty"`
-	Amount          *string   `json:"amount,omitempty"`
+	// Amount matches events of exactly this amount; see EventFilter.
+	Amount          *Amount   `json:"amount,omitempty"`
 }
 
 func (f *FTMintFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *FTMintFilter) EventType() EventType { return EventTypeFTMint }
+
 // FTBurnFilter represents a fungible token burn event filter.
 type FTBurnFilter struct {
 	Type            EventType `json:"type"`
-	Asset           string    `json:"asset"`
+	Asset           AssetIdentifier `json:"asset"`
 	Sender          *Principal `json:"sender,omitempty"`
-	Amount          *string   `json:"amount,omitempty"`
+	// Amount matches events of exactly this amount; see EventFilter.
+	Amount          *Amount   `json:"amount,omitempty"`
 }
 
 func (f *FTBurnFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *FTBurnFilter) EventType() EventType { return EventTypeFTBurn }
+
 // FTTransferFilter represents a fungible token transfer event filter.
 type FTTransferFilter struct {
 	Type            EventType `json:"type"`
-	Asset           string    `json:"asset"`
+	Asset           AssetIdentifier `json:"asset"`
 	Sender          *Principal `json:"sender,omitempty"`
 	Recipient       *Principal `json:"recipient,omitempty"`
-	Amount          *string   `json:"amount,omitempty"`
+	// Amount matches events of exactly this amount; see EventFilter.
+	Amount          *Amount   `json:"amount,omitempty"`
 }
 
 func (f *FTTransferFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *FTTransferFilter) EventType() EventType { return EventTypeFTTransfer }
+
 // NFTEventFilter represents an NFT event filter.
 type NFTEventFilter struct {
 	Type            EventType `json:"type"`
-	Asset           string    `json:"asset"`
+	Asset           AssetIdentifier `json:"asset"`
 	Action          string    `json:"action"`
 	Sender          *Principal `json:"sender,omitempty"`
 	Receiver        *Principal `json:"receiver,omitempty"`
@@ -138,73 +163,101 @@ type NFTEventFilter struct {
 
 func (f *NFTEventFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *NFTEventFilter) EventType() EventType { return EventTypeNFTEvent }
+
 // NFTMintFilter represents an NFT mint event filter.
 type NFTMintFilter struct {
 	Type            EventType `json:"type"`
-	Asset           string    `json:"asset"`
+	Asset           AssetIdentifier `json:"asset"`
 	Recipient       *Principal `json:"recipient,omitempty"`
 }
 
 func (f *NFTMintFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *NFTMintFilter) EventType() EventType { return EventTypeNFTMint }
+
 // NFTBurnFilter represents an NFT burn event filter.
 type NFTBurnFilter struct {
 	Type            EventType `json:"type"`
-	Asset           string    `json:"asset"`
+	Asset           AssetIdentifier `json:"asset"`
 	Sender          *Principal `json:"sender,omitempty"`
 }
 
 func (f *NFTBurnFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *NFTBurnFilter) EventType() EventType { return EventTypeNFTBurn }
+
 // NFTTransferFilter represents an NFT transfer event filter.
 type NFTTransferFilter struct {
 	Type            EventType `json:"type"`
-	Asset           string    `json:"asset"`
+	Asset           AssetIdentifier `json:"asset"`
 	Sender          *Principal `json:"sender,omitempty"`
 	Recipient       *Principal `json:"recipient,omitempty"`
 }
 
 func (f *NFTTransferFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *NFTTransferFilter) EventType() EventType { return EventTypeNFTTransfer }
+
 // STXEventFilter represents an STX (Stacks native token) event filter.
 type STXEventFilter struct {
 	Type            EventType `json:"type"`
 	Action          string    `json:"action"`
 	Sender          *Principal `json:"sender,omitempty"`
 	Receiver        *Principal `json:"receiver,omitempty"`
-	Amount          *string   `json:"amount,omitempty"`
+	// Amount matches events of exactly this amount; see EventFilter.
+	Amount          *Amount   `json:"amount,omitempty"`
 }
 
 func (f *STXEventFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *STXEventFilter) EventType() EventType { return EventTypeSTXEvent }
+
 // STXMintFilter represents an STX mint event filter.
 type STXMintFilter struct {
 	Type            EventType `json:"type"`
 	Recipient       *Principal `json:"recipient,omitempty"`
-	Amount          *string   `json:"amount,omitempty"`
+	// Amount matches events of exactly this amount; see EventFilter.
+	Amount          *Amount   `json:"amount,omitempty"`
 }
 
 func (f *STXMintFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *STXMintFilter) EventType() EventType { return EventTypeSTXMint }
+
 // STXBurnFilter represents an STX burn event filter.
 type STXBurnFilter struct {
 	Type            EventType `json:"type"`
 	Sender          *Principal `json:"sender,omitempty"`
-	Amount          *string   `json:"amount,omitempty"`
+	// Amount matches events of exactly this amount; see EventFilter.
+	Amount          *Amount   `json:"amount,omitempty"`
 }
 
 func (f *STXBurnFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *STXBurnFilter) EventType() EventType { return EventTypeSTXBurn }
+
 // STXTransferFilter represents an STX transfer event filter.
 type STXTransferFilter struct {
 	Type            EventType `json:"type"`
 	Sender          *Principal `json:"sender,omitempty"`
 	Recipient       *Principal `json:"recipient,omitempty"`
-	Amount          *string   `json:"amount,omitempty"`
+	// Amount matches events of exactly this amount; see EventFilter.
+	Amount          *Amount   `json:"amount,omitempty"`
 }
 
 func (f *STXTransferFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *STXTransferFilter) EventType() EventType { return EventTypeSTXTransfer }
+
 // ContractDeployFilter represents a contract deployment event filter.
 type ContractDeployFilter struct {
 	Type            EventType `json:"type"`
@@ -213,6 +266,9 @@ type ContractDeployFilter struct {
 
 func (f *ContractDeployFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *ContractDeployFilter) EventType() EventType { return EventTypeContractDeploy }
+
 // ContractCallFilter represents a contract call event filter.
 type ContractCallFilter struct {
 	Type            EventType `json:"type"`
@@ -223,6 +279,9 @@ type ContractCallFilter struct {
 
 func (f *ContractCallFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *ContractCallFilter) EventType() EventType { return EventTypeContractCall }
+
 // ContractLogFilter represents a contract log event filter.
 type ContractLogFilter struct {
 	Type            EventType `json:"type"`
@@ -231,6 +290,9 @@ type ContractLogFilter struct {
 
 func (f *ContractLogFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *ContractLogFilter) EventType() EventType { return EventTypeContractLog }
+
 // BalanceChangeFilter represents a balance change event filter.
 type BalanceChangeFilter struct {
 	Type            EventType `json:"type"`
@@ -239,6 +301,9 @@ type BalanceChangeFilter struct {
 
 func (f *BalanceChangeFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *BalanceChangeFilter) EventType() EventType { return EventTypeBalanceChange }
+
 // CoinbaseFilter represents a coinbase event filter.
 type CoinbaseFilter struct {
 	Type            EventType `json:"type"`
@@ -247,6 +312,9 @@ type CoinbaseFilter struct {
 
 func (f *CoinbaseFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *CoinbaseFilter) EventType() EventType { return EventTypeCoinbase }
+
 // TenureChangeFilter represents a tenure change event filter.
 type TenureChangeFilter struct {
 	Type            EventType `json:"type"`
@@ -254,6 +322,9 @@ type TenureChangeFilter struct {
 
 func (f *TenureChangeFilter) eventFilterMarker() {}
 
+// EventType implements EventFilter.
+func (f *TenureChangeFilter) EventType() EventType { return EventTypeTenureChange }
+
 // ============================================================================
 // Chainhook Definition
 // ============================================================================
@@ -274,7 +345,10 @@ type ChainhookOptions struct {
 
 // ChainhookFilters represents the event filters for a chainhook.
 type ChainhookFilters struct {
-	Events []interface{} `json:"events"`
+	Events []EventFilter `json:"events"`
+
+	// received holds the encoding of each filter as decoded by UnmarshalJSON.
+	received [][]byte
 }
 
 // ChainhookAction represents the action to take when a chainhook is triggered.
@@ -346,6 +420,12 @@ type BulkEnableChainhooksRequest struct {
 	UUIDs         []UUID   `json:"uuids,omitempty"`
 	WebhookURL    *string  `json:"webhook_url,omitempty"`
 	Statuses      []ChainhookStatus `json:"statuses,omitempty"`
+
+	// ExpectedCount, when set, guards BulkEnableChainhooks: the request is
+	// previewed first and only sent if exactly this many chainhooks would
+	// change. The count the server reports must also equal it. It is not
+	// sent to the server.
+	ExpectedCount *uint64 `json:"-"`
 }
 
 // BulkEnableChainhooksResponse represents the response from a bulk enable/disable operation.
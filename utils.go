@@ -1,5 +1,11 @@
 package chainhooks
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
 // ============================================================================
 // Helper Functions for Building Filters
 // ============================================================================
@@ -40,8 +46,11 @@ func PrincipalContract(address string) *Principal {
 // ChainhookBuilder is a builder for constructing ChainhookDefinition objects.
 type ChainhookBuilder struct {
 	definition *ChainhookDefinition
-	filters    []interface{}
-	err        error
+	filters    []EventFilter
+	// methods holds the method that added each filter, so that Build can
+	// attribute problems with a filter to it.
+	methods []string
+	errs    BuilderErrors
 }
 
 // NewChainhookBuilder creates a new ChainhookBuilder.
@@ -53,14 +62,66 @@ func NewChainhookBuilder(name string, network Network) *ChainhookBuilder {
 			Chain:   DefaultChain,
 			Network: network,
 		},
-		filters: []interface{}{},
+		filters: []EventFilter{},
+	}
+}
+
+// BuilderFrom creates a ChainhookBuilder initialized with a copy of an
+// existing definition, so that it can be modified and built again without
+// changing the original.
+func BuilderFrom(def *ChainhookDefinition) *ChainhookBuilder {
+	if def == nil {
+		return nilDefinitionBuilder("BuilderFrom")
+	}
+
+	definition := *def
+	definition.Options = copyOptions(def.Options)
+	definition.Filters = ChainhookFilters{}
+	methods := make([]string, len(def.Filters.Events))
+	for i := range methods {
+		methods[i] = "BuilderFrom"
+	}
+	return &ChainhookBuilder{
+		definition: &definition,
+		filters:    copyFilters(def.Filters.Events),
+		methods:    methods,
+	}
+}
+
+// BuilderFromChainhook creates a ChainhookBuilder initialized with a copy of
+// a registered chainhook's definition.
+func BuilderFromChainhook(hook *Chainhook) *ChainhookBuilder {
+	if hook == nil || hook.Definition == nil {
+		return nilDefinitionBuilder("BuilderFromChainhook")
+	}
+	return BuilderFrom(hook.Definition)
+}
+
+// nilDefinitionBuilder returns an empty builder that records a missing
+// definition against method.
+func nilDefinitionBuilder(method string) *ChainhookBuilder {
+	b := &ChainhookBuilder{definition: &ChainhookDefinition{}, filters: []EventFilter{}}
+	b.fail(method, "", &ValidationError{Field: "definition", Reason: "definition cannot be nil"})
+	return b
+}
+
+// Clone returns an independent copy of the builder. Changes to the clone do
+// not affect the original and vice versa.
+func (b *ChainhookBuilder) Clone() *ChainhookBuilder {
+	definition := *b.definition
+	definition.Options = copyOptions(b.definition.Options)
+	return &ChainhookBuilder{
+		definition: &definition,
+		filters:    copyFilters(b.filters),
+		methods:    append([]string(nil), b.methods...),
+		errs:       append(BuilderErrors(nil), b.errs...),
 	}
 }
 
 // WithName sets the chainhook name.
 func (b *ChainhookBuilder) WithName(name string) *ChainhookBuilder {
-	if b.err != nil {
-		return b
+	if name == "" {
+		b.fail("WithName", "name", &ValidationError{Field: "name", Reason: "name cannot be empty"})
 	}
 	b.definition.Name = name
 	return b
@@ -68,8 +129,11 @@ func (b *ChainhookBuilder) WithName(name string) *ChainhookBuilder {
 
 // WithNetwork sets the network.
 func (b *ChainhookBuilder) WithNetwork(network Network) *ChainhookBuilder {
-	if b.err != nil {
-		return b
+	if network != NetworkMainnet && network != NetworkTestnet {
+		b.fail("WithNetwork", "network", &ValidationError{
+			Field:  "network",
+			Reason: fmt.Sprintf("unsupported network %q", network),
+		})
 	}
 	b.definition.Network = network
 	return b
@@ -77,28 +141,78 @@ func (b *ChainhookBuilder) WithNetwork(network Network) *ChainhookBuilder {
 
 // WithWebhookURL sets the webhook URL for the action.
 func (b *ChainhookBuilder) WithWebhookURL(url string) *ChainhookBuilder {
-	if b.err != nil {
-		return b
-	}
-	b.definition.Action = ChainhookAction{
-		Type: "http_post",
+	action := ChainhookAction{
+		Type: ActionTypeHTTPPost,
 		URL:  url,
 	}
+	v := &validator{}
+	v.action("action", action)
+	b.failAll("WithWebhookURL", "action", v.errs)
+	b.definition.Action = action
 	return b
 }
 
 // AddFilter adds an event filter to the chainhook.
 func (b *ChainhookBuilder) AddFilter(filter EventFilter) *ChainhookBuilder {
-	if b.err != nil {
+	return b.addFilter("AddFilter", filter)
+}
+
+// addFilter checks filter and adds it, or records its problems against
+// method and leaves it out.
+func (b *ChainhookBuilder) addFilter(method string, filter EventFilter) *ChainhookBuilder {
+	v := &validator{}
+	v.filter("filter", filter)
+	if len(v.errs) > 0 {
+		b.failAll(method, "filters.events", v.errs)
 		return b
 	}
 	b.filters = append(b.filters, filter)
+	b.methods = append(b.methods, method)
+	return b
+}
+
+// RemoveFilter removes every filter equal to filter. Filters are compared by
+// their canonical form, so the spelling of addresses does not matter. If no
+// filter matches, the error is recorded, as ChainhookPatch.RemoveFilter does.
+func (b *ChainhookBuilder) RemoveFilter(filter EventFilter) *ChainhookBuilder {
+	if _, err := removeFilter(b.filters, filter); err != nil {
+		b.fail("RemoveFilter", "", err)
+		return b
+	}
+
+	key := filterKey(filter)
+	filters := make([]EventFilter, 0, len(b.filters))
+	methods := make([]string, 0, len(b.methods))
+	for i, f := range b.filters {
+		if filterKey(f) != key {
+			filters = append(filters, f)
+			methods = append(methods, b.methods[i])
+		}
+	}
+	b.filters, b.methods = filters, methods
+	return b
+}
+
+// ReplaceFilters replaces all filters with the given ones. Each filter is
+// checked as by AddFilter, and invalid ones are left out.
+func (b *ChainhookBuilder) ReplaceFilters(filters ...EventFilter) *ChainhookBuilder {
+	b.ClearFilters()
+	for _, filter := range filters {
+		b.addFilter("ReplaceFilters", filter)
+	}
+	return b
+}
+
+// ClearFilters removes all filters.
+func (b *ChainhookBuilder) ClearFilters() *ChainhookBuilder {
+	b.filters = []EventFilter{}
+	b.methods = nil
 	return b
 }
 
 // AddFTTransfer adds a fungible token transfer filter.
-func (b *ChainhookBuilder) AddFTTransfer(asset string, sender, receiver *Principal, amount *string) *ChainhookBuilder {
-	return b.AddFilter(&FTTransferFilter{
+func (b *ChainhookBuilder) AddFTTransfer(asset AssetIdentifier, sender, receiver *Principal, amount *Amount) *ChainhookBuilder {
+	return b.addFilter("AddFTTransfer", &FTTransferFilter{
 		Type:      EventTypeFTTransfer,
 		Asset:     asset,
 		Sender:    sender,
@@ -108,8 +222,8 @@ func (b *ChainhookBuilder) AddFTTransfer(asset string, sender, receiver *Princip
 }
 
 // AddFTMint adds a fungible token mint filter.
-func (b *ChainhookBuilder) AddFTMint(asset string, recipient *Principal, amount *string) *ChainhookBuilder {
-	return b.AddFilter(&FTMintFilter{
+func (b *ChainhookBuilder) AddFTMint(asset AssetIdentifier, recipient *Principal, amount *Amount) *ChainhookBuilder {
+	return b.addFilter("AddFTMint", &FTMintFilter{
 		Type:      EventTypeFTMint,
 		Asset:     asset,
 		Recipient: recipient,
@@ -118,8 +232,8 @@ func (b *ChainhookBuilder) AddFTMint(asset string, recipient *Principal, amount
 }
 
 // AddFTBurn adds a fungible token burn filter.
-func (b *ChainhookBuilder) AddFTBurn(asset string, sender *Principal, amount *string) *ChainhookBuilder {
-	return b.AddFilter(&FTBurnFilter{
+func (b *ChainhookBuilder) AddFTBurn(asset AssetIdentifier, sender *Principal, amount *Amount) *ChainhookBuilder {
+	return b.addFilter("AddFTBurn", &FTBurnFilter{
 		Type:   EventTypeFTBurn,
 		Asset:  asset,
 		Sender: sender,
@@ -128,8 +242,8 @@ func (b *ChainhookBuilder) AddFTBurn(asset string, sender *Principal, amount *st
 }
 
 // AddNFTTransfer adds an NFT transfer filter.
-func (b *ChainhookBuilder) AddNFTTransfer(asset string, sender, receiver *Principal) *ChainhookBuilder {
-	return b.AddFilter(&NFTTransferFilter{
+func (b *ChainhookBuilder) AddNFTTransfer(asset AssetIdentifier, sender, receiver *Principal) *ChainhookBuilder {
+	return b.addFilter("AddNFTTransfer", &NFTTransferFilter{
 		Type:      EventTypeNFTTransfer,
 		Asset:     asset,
 		Sender:    sender,
@@ -138,8 +252,8 @@ func (b *ChainhookBuilder) AddNFTTransfer(asset string, sender, receiver *Princi
 }
 
 // AddNFTMint adds an NFT mint filter.
-func (b *ChainhookBuilder) AddNFTMint(asset string, recipient *Principal) *ChainhookBuilder {
-	return b.AddFilter(&NFTMintFilter{
+func (b *ChainhookBuilder) AddNFTMint(asset AssetIdentifier, recipient *Principal) *ChainhookBuilder {
+	return b.addFilter("AddNFTMint", &NFTMintFilter{
 		Type:      EventTypeNFTMint,
 		Asset:     asset,
 		Recipient: recipient,
@@ -147,8 +261,8 @@ func (b *ChainhookBuilder) AddNFTMint(asset string, recipient *Principal) *Chain
 }
 
 // AddNFTBurn adds an NFT burn filter.
-func (b *ChainhookBuilder) AddNFTBurn(asset string, sender *Principal) *ChainhookBuilder {
-	return b.AddFilter(&NFTBurnFilter{
+func (b *ChainhookBuilder) AddNFTBurn(asset AssetIdentifier, sender *Principal) *ChainhookBuilder {
+	return b.addFilter("AddNFTBurn", &NFTBurnFilter{
 		Type:   EventTypeNFTBurn,
 		Asset:  asset,
 		Sender: sender,
@@ -156,8 +270,8 @@ func (b *ChainhookBuilder) AddNFTBurn(asset string, sender *Principal) *Chainhoo
 }
 
 // AddSTXTransfer adds an STX transfer filter.
-func (b *ChainhookBuilder) AddSTXTransfer(sender, receiver *Principal, amount *string) *ChainhookBuilder {
-	return b.AddFilter(&STXTransferFilter{
+func (b *ChainhookBuilder) AddSTXTransfer(sender, receiver *Principal, amount *Amount) *ChainhookBuilder {
+	return b.addFilter("AddSTXTransfer", &STXTransferFilter{
 		Type:      EventTypeSTXTransfer,
 		Sender:    sender,
 		Recipient: receiver,
@@ -166,8 +280,8 @@ func (b *ChainhookBuilder) AddSTXTransfer(sender, receiver *Principal, amount *s
 }
 
 // AddSTXMint adds an STX mint filter.
-func (b *ChainhookBuilder) AddSTXMint(recipient *Principal, amount *string) *ChainhookBuilder {
-	return b.AddFilter(&STXMintFilter{
+func (b *ChainhookBuilder) AddSTXMint(recipient *Principal, amount *Amount) *ChainhookBuilder {
+	return b.addFilter("AddSTXMint", &STXMintFilter{
 		Type:      EventTypeSTXMint,
 		Recipient: recipient,
 		Amount:    amount,
@@ -175,8 +289,8 @@ func (b *ChainhookBuilder) AddSTXMint(recipient *Principal, amount *string) *Cha
 }
 
 // AddSTXBurn adds an STX burn filter.
-func (b *ChainhookBuilder) AddSTXBurn(sender *Principal, amount *string) *ChainhookBuilder {
-	return b.AddFilter(&STXBurnFilter{
+func (b *ChainhookBuilder) AddSTXBurn(sender *Principal, amount *Amount) *ChainhookBuilder {
+	return b.addFilter("AddSTXBurn", &STXBurnFilter{
 		Type:   EventTypeSTXBurn,
 		Sender: sender,
 		Amount: amount,
@@ -185,7 +299,7 @@ func (b *ChainhookBuilder) AddSTXBurn(sender *Principal, amount *string) *Chainh
 
 // AddContractDeploy adds a contract deployment filter.
 func (b *ChainhookBuilder) AddContractDeploy(deployerPrincipal *Principal) *ChainhookBuilder {
-	return b.AddFilter(&ContractDeployFilter{
+	return b.addFilter("AddContractDeploy", &ContractDeployFilter{
 		Type:              EventTypeContractDeploy,
 		DeployerPrincipal: deployerPrincipal,
 	})
@@ -193,7 +307,7 @@ func (b *ChainhookBuilder) AddContractDeploy(deployerPrincipal *Principal) *Chai
 
 // AddContractCall adds a contract call filter.
 func (b *ChainhookBuilder) AddContractCall(contractID *string, method *string, sender *Principal) *ChainhookBuilder {
-	return b.AddFilter(&ContractCallFilter{
+	return b.addFilter("AddContractCall", &ContractCallFilter{
 		Type:               EventTypeContractCall,
 		ContractIdentifier: contractID,
 		Method:             method,
@@ -203,7 +317,7 @@ func (b *ChainhookBuilder) AddContractCall(contractID *string, method *string, s
 
 // AddContractLog adds a contract log filter.
 func (b *ChainhookBuilder) AddContractLog(contractID *string) *ChainhookBuilder {
-	return b.AddFilter(&ContractLogFilter{
+	return b.addFilter("AddContractLog", &ContractLogFilter{
 		Type:               EventTypeContractLog,
 		ContractIdentifier: contractID,
 	})
@@ -211,7 +325,7 @@ func (b *ChainhookBuilder) AddContractLog(contractID *string) *ChainhookBuilder
 
 // AddBalanceChange adds a balance change filter.
 func (b *ChainhookBuilder) AddBalanceChange(principal *Principal) *ChainhookBuilder {
-	return b.AddFilter(&BalanceChangeFilter{
+	return b.addFilter("AddBalanceChange", &BalanceChangeFilter{
 		Type:      EventTypeBalanceChange,
 		Principal: principal,
 	})
@@ -219,7 +333,7 @@ func (b *ChainhookBuilder) AddBalanceChange(principal *Principal) *ChainhookBuil
 
 // AddCoinbase adds a coinbase filter.
 func (b *ChainhookBuilder) AddCoinbase(recipient *Principal) *ChainhookBuilder {
-	return b.AddFilter(&CoinbaseFilter{
+	return b.addFilter("AddCoinbase", &CoinbaseFilter{
 		Type:      EventTypeCoinbase,
 		Recipient: recipient,
 	})
@@ -227,15 +341,17 @@ func (b *ChainhookBuilder) AddCoinbase(recipient *Principal) *ChainhookBuilder {
 
 // AddTenureChange adds a tenure change filter.
 func (b *ChainhookBuilder) AddTenureChange() *ChainhookBuilder {
-	return b.AddFilter(&TenureChangeFilter{
+	return b.addFilter("AddTenureChange", &TenureChangeFilter{
 		Type: EventTypeTenureChange,
 	})
 }
 
 // WithOptions sets the options for the chainhook.
 func (b *ChainhookBuilder) WithOptions(opts *ChainhookOptions) *ChainhookBuilder {
-	if b.err != nil {
-		return b
+	if opts != nil {
+		v := &validator{}
+		v.options("options", opts)
+		b.failAll("WithOptions", "options", v.errs)
 	}
 	b.definition.Options = opts
 	return b
@@ -243,9 +359,6 @@ func (b *ChainhookBuilder) WithOptions(opts *ChainhookOptions) *ChainhookBuilder
 
 // WithEnableOnRegistration sets whether to enable the chainhook on registration.
 func (b *ChainhookBuilder) WithEnableOnRegistration(enable bool) *ChainhookBuilder {
-	if b.err != nil {
-		return b
-	}
 	if b.definition.Options == nil {
 		b.definition.Options = &ChainhookOptions{}
 	}
@@ -255,8 +368,8 @@ func (b *ChainhookBuilder) WithEnableOnRegistration(enable bool) *ChainhookBuild
 
 // WithExpireAfterEvaluations sets the expiration after evaluations.
 func (b *ChainhookBuilder) WithExpireAfterEvaluations(count uint64) *ChainhookBuilder {
-	if b.err != nil {
-		return b
+	if count == 0 {
+		b.fail("WithExpireAfterEvaluations", "options.expire_after_evaluations", &ValidationError{Field: "options.expire_after_evaluations", Reason: "must be greater than zero"})
 	}
 	if b.definition.Options == nil {
 		b.definition.Options = &ChainhookOptions{}
@@ -267,8 +380,8 @@ func (b *ChainhookBuilder) WithExpireAfterEvaluations(count uint64) *ChainhookBu
 
 // WithExpireAfterOccurrences sets the expiration after occurrences.
 func (b *ChainhookBuilder) WithExpireAfterOccurrences(count uint64) *ChainhookBuilder {
-	if b.err != nil {
-		return b
+	if count == 0 {
+		b.fail("WithExpireAfterOccurrences", "options.expire_after_occurrences", &ValidationError{Field: "options.expire_after_occurrences", Reason: "must be greater than zero"})
 	}
 	if b.definition.Options == nil {
 		b.definition.Options = &ChainhookOptions{}
@@ -279,9 +392,6 @@ func (b *ChainhookBuilder) WithExpireAfterOccurrences(count uint64) *ChainhookBu
 
 // WithDecodeClarityValues sets whether to decode Clarity values.
 func (b *ChainhookBuilder) WithDecodeClarityValues(decode bool) *ChainhookBuilder {
-	if b.err != nil {
-		return b
-	}
 	if b.definition.Options == nil {
 		b.definition.Options = &ChainhookOptions{}
 	}
@@ -291,9 +401,6 @@ func (b *ChainhookBuilder) WithDecodeClarityValues(decode bool) *ChainhookBuilde
 
 // WithIncludeContractABI sets whether to include contract ABI.
 func (b *ChainhookBuilder) WithIncludeContractABI(include bool) *ChainhookBuilder {
-	if b.err != nil {
-		return b
-	}
 	if b.definition.Options == nil {
 		b.definition.Options = &ChainhookOptions{}
 	}
@@ -303,9 +410,6 @@ func (b *ChainhookBuilder) WithIncludeContractABI(include bool) *ChainhookBuilde
 
 // WithIncludeContractSourceCode sets whether to include contract source code.
 func (b *ChainhookBuilder) WithIncludeContractSourceCode(include bool) *ChainhookBuilder {
-	if b.err != nil {
-		return b
-	}
 	if b.definition.Options == nil {
 		b.definition.Options = &ChainhookOptions{}
 	}
@@ -315,9 +419,6 @@ func (b *ChainhookBuilder) WithIncludeContractSourceCode(include bool) *Chainhoo
 
 // WithIncludePostConditions sets whether to include post conditions.
 func (b *ChainhookBuilder) WithIncludePostConditions(include bool) *ChainhookBuilder {
-	if b.err != nil {
-		return b
-	}
 	if b.definition.Options == nil {
 		b.definition.Options = &ChainhookOptions{}
 	}
@@ -327,9 +428,6 @@ func (b *ChainhookBuilder) WithIncludePostConditions(include bool) *ChainhookBui
 
 // WithIncludeRawTransactions sets whether to include raw transactions.
 func (b *ChainhookBuilder) WithIncludeRawTransactions(include bool) *ChainhookBuilder {
-	if b.err != nil {
-		return b
-	}
 	if b.definition.Options == nil {
 		b.definition.Options = &ChainhookOptions{}
 	}
@@ -339,9 +437,6 @@ func (b *ChainhookBuilder) WithIncludeRawTransactions(include bool) *ChainhookBu
 
 // WithIncludeBlockSignatures sets whether to include block signatures.
 func (b *ChainhookBuilder) WithIncludeBlockSignatures(include bool) *ChainhookBuilder {
-	if b.err != nil {
-		return b
-	}
 	if b.definition.Options == nil {
 		b.definition.Options = &ChainhookOptions{}
 	}
@@ -351,9 +446,6 @@ func (b *ChainhookBuilder) WithIncludeBlockSignatures(include bool) *ChainhookBu
 
 // WithIncludeBlockMetadata sets whether to include block metadata.
 func (b *ChainhookBuilder) WithIncludeBlockMetadata(include bool) *ChainhookBuilder {
-	if b.err != nil {
-		return b
-	}
 	if b.definition.Options == nil {
 		b.definition.Options = &ChainhookOptions{}
 	}
@@ -362,38 +454,141 @@ func (b *ChainhookBuilder) WithIncludeBlockMetadata(include bool) *ChainhookBuil
 }
 
 // Build validates and returns the ChainhookDefinition.
+//
+// The definition is checked with ChainhookDefinition.Validate, so the
+// returned error lists every problem found. If builder methods were given
+// invalid arguments, the error is a BuilderErrors naming the method behind
+// each problem; otherwise it is the error returned by Validate. Problems
+// that only show once the definition is assembled, such as an address from
+// the wrong network, are attributed to the method that added the filter.
 func (b *ChainhookBuilder) Build() (*ChainhookDefinition, error) {
-	if b.err != nil {
-		return nil, b.err
+	b.definition.Filters = ChainhookFilters{
+		Events: b.filters,
 	}
 
-	// Validate required fields
-	if b.definition.Name == "" {
-		return nil, &ValidationError{
-			Field:  "name",
-			Reason: "name is required",
+	err := b.definition.Validate()
+	errs := append(BuilderErrors(nil), b.errs...)
+	attributed := len(b.errs) > 0
+	if verrs, ok := err.(ValidationErrors); ok {
+		for _, verr := range verrs {
+			if b.reported(verr.Field) {
+				continue
+			}
+			method := b.filterMethod(verr.Field)
+			if method == "" {
+				method = "Build"
+			} else {
+				attributed = true
+			}
+			errs = append(errs, &BuilderError{Method: method, Err: verr})
 		}
 	}
 
-	if b.definition.Action.URL == "" {
-		return nil, &ValidationError{
-			Field:  "action.url",
-			Reason: "webhook URL is required",
-		}
+	switch {
+	case attributed:
+		return nil, errs
+	case err != nil:
+		return nil, err
 	}
+	return b.definition, nil
+}
 
-	if len(b.filters) == 0 {
-		return nil, &ValidationError{
-			Field:  "filters",
-			Reason: "at least one filter is required",
-		}
+// filterMethod returns the method that added the filter a validation error
+// on field refers to, or "" if field is not within a filter.
+func (b *ChainhookBuilder) filterMethod(field string) string {
+	var index int
+	if _, err := fmt.Sscanf(field, "filters.events[%d]", &index); err != nil {
+		return ""
 	}
+	if index < 0 || index >= len(b.methods) {
+		return ""
+	}
+	return b.methods[index]
+}
 
-	b.definition.Filters = ChainhookFilters{
-		Events: b.filters,
+// MustBuild is like Build but panics if the definition is invalid. It is
+// intended for tests and static configuration.
+func (b *ChainhookBuilder) MustBuild() *ChainhookDefinition {
+	definition, err := b.Build()
+	if err != nil {
+		panic(err)
 	}
+	return definition
+}
 
-	return b.definition, nil
+// fail records err against method. field is the JSON path of the
+// definition field left invalid by the failed call, so that Build does not
+// report it again; it is empty when the field was left unchanged.
+func (b *ChainhookBuilder) fail(method, field string, err error) {
+	b.errs = append(b.errs, &BuilderError{Method: method, Err: err, field: field})
+}
+
+// failAll records each validation error against method.
+func (b *ChainhookBuilder) failAll(method, field string, errs ValidationErrors) {
+	for _, err := range errs {
+		b.fail(method, field, err)
+	}
+}
+
+// reported reports whether a validation error on field is already covered by
+// a builder error.
+func (b *ChainhookBuilder) reported(field string) bool {
+	for _, err := range b.errs {
+		if err.field != "" && (field == err.field || strings.HasPrefix(field, err.field+".")) {
+			return true
+		}
+	}
+	return false
+}
+
+// copyOptions returns a copy of opts that shares no pointers with it.
+func copyOptions(opts *ChainhookOptions) *ChainhookOptions {
+	if opts == nil {
+		return nil
+	}
+	copied := &ChainhookOptions{}
+	mergeOptions(copied, opts)
+	for _, field := range []**bool{
+		&copied.EnableOnRegistration,
+		&copied.DecodeClarityValues,
+		&copied.IncludeContractABI,
+		&copied.IncludeContractSourceCode,
+		&copied.IncludePostConditions,
+		&copied.IncludeRawTransactions,
+		&copied.IncludeBlockSignatures,
+		&copied.IncludeBlockMetadata,
+	} {
+		if *field != nil {
+			*field = BoolPtr(**field)
+		}
+	}
+	if copied.ExpireAfterEvaluations != nil {
+		copied.ExpireAfterEvaluations = Uint64Ptr(*copied.ExpireAfterEvaluations)
+	}
+	if copied.ExpireAfterOccurrences != nil {
+		copied.ExpireAfterOccurrences = Uint64Ptr(*copied.ExpireAfterOccurrences)
+	}
+	return copied
+}
+
+// copyFilters returns deep copies of filters. Filters that cannot be
+// re-encoded are shared rather than dropped.
+func copyFilters(filters []EventFilter) []EventFilter {
+	copied := make([]EventFilter, len(filters))
+	for i, filter := range filters {
+		copied[i] = filter
+		if filter == nil {
+			continue
+		}
+		data, err := json.Marshal(filter)
+		if err != nil {
+			continue
+		}
+		if decoded, err := DecodeEventFilter(data); err == nil {
+			copied[i] = decoded
+		}
+	}
+	return copied
 }
 
 // ============================================================================
@@ -0,0 +1,96 @@
+package chainhooks
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/tony1908/chainhooks-client-go/stacks"
+)
+
+func TestBuilderErrorMethods(t *testing.T) {
+	testnetAddress := stacks.NewAddress(stacks.AddressVersionTestnetSingleSig, [20]byte{}).String()
+	valid := func(network Network) *ChainhookBuilder {
+		return NewChainhookBuilder("my-hook", network).WithWebhookURL("https://example.com/webhook")
+	}
+
+	tests := []struct {
+		name    string
+		builder *ChainhookBuilder
+		want    []string
+	}{
+		{"valid", valid(NetworkMainnet).AddCoinbase(nil), nil},
+		{"name", valid(NetworkMainnet).AddCoinbase(nil).WithName(""), []string{"WithName"}},
+		{"network", valid(NetworkMainnet).AddCoinbase(nil).WithNetwork("devnet"), []string{"WithNetwork"}},
+		{"webhook URL", valid(NetworkMainnet).AddCoinbase(nil).WithWebhookURL("ftp://example.com"), []string{"WithWebhookURL"}},
+		{"nil filter", valid(NetworkMainnet).AddCoinbase(nil).AddFilter(nil), []string{"AddFilter"}},
+		{"filter argument", valid(NetworkMainnet).AddCoinbase(nil).AddFTMint("USDA", nil, nil), []string{"AddFTMint"}},
+		{"replace with nil", valid(NetworkMainnet).AddCoinbase(nil).ReplaceFilters(&CoinbaseFilter{}, nil), []string{"ReplaceFilters"}},
+		{"remove nil", valid(NetworkMainnet).AddCoinbase(nil).RemoveFilter(nil), []string{"RemoveFilter"}},
+		{"remove missing", valid(NetworkMainnet).AddCoinbase(nil).RemoveFilter(&TenureChangeFilter{}), []string{"RemoveFilter"}},
+		{
+			"network mismatch",
+			valid(NetworkTestnet).
+				AddCoinbase(PrincipalStandard(testnetAddress)).
+				AddSTXTransfer(PrincipalStandard(testAddress), nil, nil).
+				AddContractLog(StringPtr(testAddress + ".pool")),
+			[]string{"AddSTXTransfer", "AddContractLog"},
+		},
+		{
+			"network changed after adding",
+			valid(NetworkMainnet).ReplaceFilters(&BalanceChangeFilter{Principal: PrincipalStandard(testAddress)}).WithNetwork(NetworkTestnet),
+			[]string{"ReplaceFilters"},
+		},
+		{
+			"copied definition",
+			BuilderFrom(valid(NetworkMainnet).AddCoinbase(PrincipalStandard(testAddress)).MustBuild()).WithNetwork(NetworkTestnet),
+			[]string{"BuilderFrom"},
+		},
+		{"expire count", valid(NetworkMainnet).AddCoinbase(nil).WithExpireAfterEvaluations(0), []string{"WithExpireAfterEvaluations"}},
+		{
+			"options",
+			valid(NetworkMainnet).AddCoinbase(nil).WithOptions(&ChainhookOptions{ExpireAfterOccurrences: Uint64Ptr(0)}),
+			[]string{"WithOptions"},
+		},
+		{
+			"assembled definition",
+			valid(NetworkMainnet).AddCoinbase(nil).AddFilter(nil).WithIncludeContractABI(true),
+			[]string{"AddFilter", "Build"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.builder.Build()
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var errs BuilderErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("expected BuilderErrors, got %v", err)
+			}
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Method)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got errors from %v, want %v: %v", got, tt.want, err)
+			}
+		})
+	}
+}
+
+func TestBuilderValidationErrorsWithoutBuilderProblems(t *testing.T) {
+	// Problems outside any filter are not attributed to a method, so Build
+	// returns the validation errors as they are.
+	_, err := NewChainhookBuilder("my-hook", NetworkMainnet).
+		WithWebhookURL("https://example.com/webhook").
+		Build()
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs) != 1 || verrs[0].Field != "filters.events" {
+		t.Fatalf("expected a filters.events validation error, got %v", err)
+	}
+}
@@ -0,0 +1,282 @@
+package chainhooks
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/tony1908/chainhooks-client-go/stacks"
+)
+
+// ============================================================================
+// Definition Validation
+// ============================================================================
+
+// clarityNamePattern matches a Clarity identifier such as an asset or method name.
+var clarityNamePattern = regexp.MustCompile(`^[a-zA-Z]([a-zA-Z0-9]|[-_!?+<>=/*])*$`)
+
+// Validate checks the definition and every filter and option in it. It
+// returns nil or a ValidationErrors listing every problem found, each with
+// the JSON path of the offending field, such as "filters.events[2].asset".
+//
+// A *RawEventFilter is always reported, since this client cannot check it. A
+// definition fetched from a server that supports event types or filter
+// fields unknown to this client version therefore fails validation, and so
+// cannot be sent back with RegisterChainhook or UpdateChainhook until those
+// filters are replaced or removed.
+func (d *ChainhookDefinition) Validate() error {
+	v := &validator{}
+	v.definition(d)
+	return v.err()
+}
+
+// validator accumulates validation errors.
+type validator struct {
+	errs ValidationErrors
+	// network, when set, is the network every address must belong to.
+	network Network
+}
+
+// add records a validation error for field.
+func (v *validator) add(field, format string, args ...interface{}) {
+	v.errs = append(v.errs, &ValidationError{
+		Field:  field,
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
+
+// err returns the accumulated errors, or nil if there are none.
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+func (v *validator) definition(d *ChainhookDefinition) {
+	if d == nil {
+		v.add("definition", "definition cannot be nil")
+		return
+	}
+
+	if d.Name == "" {
+		v.add("name", "name is required")
+	}
+	if d.Version == "" {
+		v.add("version", "version is required")
+	}
+	if d.Chain != ChainStacks {
+		v.add("chain", "unsupported chain %q", d.Chain)
+	}
+	if d.Network != NetworkMainnet && d.Network != NetworkTestnet {
+		v.add("network", "unsupported network %q", d.Network)
+	} else {
+		v.network = d.Network
+	}
+
+	v.action("action", d.Action)
+
+	if len(d.Filters.Events) == 0 {
+		v.add("filters.events", "at least one filter is required")
+	}
+	for i, filter := range d.Filters.Events {
+		v.filter(fmt.Sprintf("filters.events[%d]", i), filter)
+	}
+
+	if d.Options != nil {
+		v.options("options", d.Options)
+		v.optionFilters("options", d.Options, d.Filters.Events)
+	}
+}
+
+func (v *validator) action(path string, action ChainhookAction) {
+	if action.Type != ActionTypeHTTPPost {
+		v.add(path+".type", "unsupported action type %q", action.Type)
+	}
+
+	if action.URL == "" {
+		v.add(path+".url", "webhook URL is required")
+		return
+	}
+	u, err := url.Parse(action.URL)
+	if err != nil {
+		v.add(path+".url", "invalid webhook URL: %v", err)
+		return
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		v.add(path+".url", "webhook URL scheme must be http or https, got %q", u.Scheme)
+	}
+	if u.Host == "" {
+		v.add(path+".url", "webhook URL must include a host")
+	}
+}
+
+func (v *validator) options(path string, opts *ChainhookOptions) {
+	if opts.ExpireAfterEvaluations != nil && *opts.ExpireAfterEvaluations == 0 {
+		v.add(path+".expire_after_evaluations", "must be greater than zero")
+	}
+	if opts.ExpireAfterOccurrences != nil && *opts.ExpireAfterOccurrences == 0 {
+		v.add(path+".expire_after_occurrences", "must be greater than zero")
+	}
+}
+
+// optionFilters checks options that only apply to some filters. Contract
+// source code and ABIs are only delivered with contract deployments, so
+// enabling them without a contract_deploy filter is an error.
+func (v *validator) optionFilters(path string, opts *ChainhookOptions, filters []EventFilter) {
+	for _, filter := range filters {
+		if _, ok := filter.(*ContractDeployFilter); ok {
+			return
+		}
+	}
+	if opts.IncludeContractSourceCode != nil && *opts.IncludeContractSourceCode {
+		v.add(path+".include_contract_source_code", "only applies to contract deployments, but no contract_deploy filter is set")
+	}
+	if opts.IncludeContractABI != nil && *opts.IncludeContractABI {
+		v.add(path+".include_contract_abi", "only applies to contract deployments, but no contract_deploy filter is set")
+	}
+}
+
+func (v *validator) filter(path string, filter EventFilter) {
+	switch f := filter.(type) {
+	case nil:
+		v.add(path, "filter cannot be nil")
+	case *FTEventFilter:
+		v.asset(path+".asset", f.Asset)
+		v.filterAction(path+".action", f.Action, FilterActionMint, FilterActionBurn, FilterActionTransfer)
+		v.principal(path+".sender", f.Sender)
+		v.principal(path+".receiver", f.Receiver)
+	case *FTMintFilter:
+		v.asset(path+".asset", f.Asset)
+		v.principal(path+".recipient", f.Recipient)
+	case *FTBurnFilter:
+		v.asset(path+".asset", f.Asset)
+		v.principal(path+".sender", f.Sender)
+	case *FTTransferFilter:
+		v.asset(path+".asset", f.Asset)
+		v.principal(path+".sender", f.Sender)
+		v.principal(path+".recipient", f.Recipient)
+	case *NFTEventFilter:
+		v.asset(path+".asset", f.Asset)
+		v.filterAction(path+".action", f.Action, FilterActionMint, FilterActionBurn, FilterActionTransfer)
+		v.principal(path+".sender", f.Sender)
+		v.principal(path+".receiver", f.Receiver)
+	case *NFTMintFilter:
+		v.asset(path+".asset", f.Asset)
+		v.principal(path+".recipient", f.Recipient)
+	case *NFTBurnFilter:
+		v.asset(path+".asset", f.Asset)
+		v.principal(path+".sender", f.Sender)
+	case *NFTTransferFilter:
+		v.asset(path+".asset", f.Asset)
+		v.principal(path+".sender", f.Sender)
+		v.principal(path+".recipient", f.Recipient)
+	case *STXEventFilter:
+		v.filterAction(path+".action", f.Action, FilterActionMint, FilterActionBurn, FilterActionTransfer, FilterActionLock)
+		v.principal(path+".sender", f.Sender)
+		v.principal(path+".receiver", f.Receiver)
+	case *STXMintFilter:
+		v.principal(path+".recipient", f.Recipient)
+	case *STXBurnFilter:
+		v.principal(path+".sender", f.Sender)
+	case *STXTransferFilter:
+		v.principal(path+".sender", f.Sender)
+		v.principal(path+".recipient", f.Recipient)
+	case *ContractDeployFilter:
+		v.principal(path+".deployer_principal", f.DeployerPrincipal)
+	case *ContractCallFilter:
+		v.contractIdentifier(path+".contract_identifier", f.ContractIdentifier)
+		if f.Method != nil && (len(*f.Method) > maxClarityNameLength || !clarityNamePattern.MatchString(*f.Method)) {
+			v.add(path+".method", "invalid method name %q", *f.Method)
+		}
+		v.principal(path+".sender", f.Sender)
+	case *ContractLogFilter:
+		v.contractIdentifier(path+".contract_identifier", f.ContractIdentifier)
+	case *BalanceChangeFilter:
+		v.principal(path+".principal", f.Principal)
+	case *CoinbaseFilter:
+		v.principal(path+".recipient", f.Recipient)
+	case *TenureChangeFilter:
+		// No fields to validate
+	case *RawEventFilter:
+		if _, known := eventFilterFactories[f.Type]; known {
+			v.add(path, "%s filter has fields this client cannot represent", f.Type)
+		} else {
+			v.add(path+".type", "unsupported event type %q", f.Type)
+		}
+	default:
+		v.add(path, "unsupported filter %T", filter)
+	}
+}
+
+func (v *validator) filterAction(path, action string, allowed ...string) {
+	if action == "" {
+		return
+	}
+	for _, a := range allowed {
+		if action == a {
+			return
+		}
+	}
+	v.add(path, "invalid action %q, expected one of %s", action, strings.Join(allowed, ", "))
+}
+
+// asset checks an asset identifier of the form "<address>.<contract>::<asset>".
+func (v *validator) asset(path string, asset AssetIdentifier) {
+	if err := asset.Validate(); err != nil {
+		var verr *ValidationError
+		if errors.As(err, &verr) {
+			v.add(path, "%s", verr.Reason)
+		} else {
+			v.add(path, "%v", err)
+		}
+		return
+	}
+	contract := asset.Contract()
+	v.contractIdentifier(path, &contract)
+}
+
+func (v *validator) principal(path string, p *Principal) {
+	if p == nil {
+		return
+	}
+	switch {
+	case p.Standard != nil && p.Contract != nil:
+		v.add(path, "principal must set exactly one of standard or contract, not both")
+	case p.Standard == nil && p.Contract == nil:
+		v.add(path, "principal must set exactly one of standard or contract")
+	case p.Standard != nil:
+		addr, err := stacks.ParseAddress(*p.Standard)
+		if err != nil {
+			v.add(path+".standard", "invalid Stacks address: %v", err)
+			return
+		}
+		v.addressNetwork(path+".standard", *p.Standard, addr)
+	default:
+		v.contractIdentifier(path+".contract", p.Contract)
+	}
+}
+
+func (v *validator) contractIdentifier(path string, id *string) {
+	if id == nil {
+		return
+	}
+	addr, _, err := stacks.ParseContractIdentifier(*id)
+	if err != nil {
+		v.add(path, "%v", err)
+		return
+	}
+	v.addressNetwork(path, *id, addr)
+}
+
+// addressNetwork checks that addr belongs to the network being validated.
+func (v *validator) addressNetwork(path, value string, addr stacks.Address) {
+	switch {
+	case v.network == NetworkMainnet && !addr.IsMainnet():
+		v.add(path, "%q is a testnet address but the definition targets mainnet", value)
+	case v.network == NetworkTestnet && !addr.IsTestnet():
+		v.add(path, "%q is a mainnet address but the definition targets testnet", value)
+	}
+}
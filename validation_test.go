@@ -0,0 +1,172 @@
+package chainhooks
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/tony1908/chainhooks-client-go/stacks"
+)
+
+func TestValidate(t *testing.T) {
+	testnetAddress := stacks.NewAddress(stacks.AddressVersionTestnetSingleSig, [20]byte{}).String()
+	contract := testAddress + ".pool"
+	asset := AssetIdentifier(contract + "::token")
+	filters := func(filters ...EventFilter) func(*ChainhookDefinition) {
+		return func(d *ChainhookDefinition) { d.Filters = NewChainhookFilters(filters...) }
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*ChainhookDefinition)
+		want   []string
+	}{
+		{"valid", func(*ChainhookDefinition) {}, nil},
+		{"nil definition", nil, []string{"definition"}},
+		{
+			"definition fields",
+			func(d *ChainhookDefinition) { d.Name, d.Version, d.Chain, d.Network = "", "", "bitcoin", "devnet" },
+			[]string{"name", "version", "chain", "network"},
+		},
+		{"action type", func(d *ChainhookDefinition) { d.Action.Type = "grpc" }, []string{"action.type"}},
+		{"missing URL", func(d *ChainhookDefinition) { d.Action.URL = "" }, []string{"action.url"}},
+		{"URL scheme", func(d *ChainhookDefinition) { d.Action.URL = "ftp://example.com/webhook" }, []string{"action.url"}},
+		{"URL host", func(d *ChainhookDefinition) { d.Action.URL = "https:///webhook" }, []string{"action.url"}},
+		{"no filters", filters(), []string{"filters.events"}},
+		{"nil filter", filters(&CoinbaseFilter{}, nil), []string{"filters.events[1]"}},
+		{"asset syntax", filters(&FTMintFilter{Asset: "USDA"}), []string{"filters.events[0].asset"}},
+		{"asset name", filters(&NFTBurnFilter{Asset: AssetIdentifier(contract + "::2bad")}), []string{"filters.events[0].asset"}},
+		{"asset contract", filters(&FTBurnFilter{Asset: AssetIdentifier(testAddress + ".2pool::token")}), []string{"filters.events[0].asset"}},
+		{"token action", filters(&FTEventFilter{Asset: asset, Action: "swap"}), []string{"filters.events[0].action"}},
+		{"lock is STX only", filters(&NFTEventFilter{Asset: asset, Action: FilterActionLock}), []string{"filters.events[0].action"}},
+		{"STX action", filters(&STXEventFilter{Action: FilterActionLock}), nil},
+		{
+			"fractional amount",
+			filters(&RawEventFilter{Type: EventTypeSTXTransfer, Raw: json.RawMessage(`{"type":"stx_transfer","amount":"1.5"}`)}),
+			[]string{"filters.events[0]"},
+		},
+		{
+			"principal exclusivity",
+			filters(&STXTransferFilter{
+				Sender:    &Principal{Standard: StringPtr(testAddress), Contract: StringPtr(contract)},
+				Recipient: &Principal{},
+			}),
+			[]string{"filters.events[0].sender", "filters.events[0].recipient"},
+		},
+		{"address checksum", filters(&CoinbaseFilter{Recipient: PrincipalStandard("SP000000000000000000002Q6VF79")}), []string{"filters.events[0].recipient.standard"}},
+		{"contract principal", filters(&BalanceChangeFilter{Principal: PrincipalContract(testAddress)}), []string{"filters.events[0].principal.contract"}},
+		{"contract identifier", filters(&ContractLogFilter{ContractIdentifier: StringPtr("pool")}), []string{"filters.events[0].contract_identifier"}},
+		{"method name", filters(&ContractCallFilter{ContractIdentifier: StringPtr(contract), Method: StringPtr("bad name")}), []string{"filters.events[0].method"}},
+		{
+			"network mismatch",
+			filters(
+				&STXEventFilter{Receiver: PrincipalStandard(testnetAddress)},
+				&ContractCallFilter{ContractIdentifier: StringPtr(testnetAddress + ".pool")},
+				&FTMintFilter{Asset: AssetIdentifier(testnetAddress + ".pool::token")},
+			),
+			[]string{"filters.events[0].receiver.standard", "filters.events[1].contract_identifier", "filters.events[2].asset"},
+		},
+		{
+			"testnet",
+			func(d *ChainhookDefinition) {
+				d.Network = NetworkTestnet
+				d.Filters = NewChainhookFilters(&STXTransferFilter{Sender: PrincipalStandard(testnetAddress)}, &ContractDeployFilter{DeployerPrincipal: PrincipalStandard(testnetAddress)})
+			},
+			nil,
+		},
+		{
+			"expire counts",
+			func(d *ChainhookDefinition) {
+				d.Options = &ChainhookOptions{ExpireAfterEvaluations: Uint64Ptr(0), ExpireAfterOccurrences: Uint64Ptr(0)}
+			},
+			[]string{"options.expire_after_evaluations", "options.expire_after_occurrences"},
+		},
+		{
+			"contract options without deployments",
+			func(d *ChainhookDefinition) {
+				d.Filters = NewChainhookFilters(&ContractCallFilter{ContractIdentifier: StringPtr(contract)})
+				d.Options = &ChainhookOptions{IncludeContractSourceCode: BoolPtr(true), IncludeContractABI: BoolPtr(true)}
+			},
+			[]string{"options.include_contract_source_code", "options.include_contract_abi"},
+		},
+		{
+			"contract options disabled",
+			func(d *ChainhookDefinition) {
+				d.Filters = NewChainhookFilters(&ContractCallFilter{ContractIdentifier: StringPtr(contract)})
+				d.Options = &ChainhookOptions{IncludeContractSourceCode: BoolPtr(false), IncludeContractABI: BoolPtr(false)}
+			},
+			nil,
+		},
+		{
+			"contract options with deployments",
+			func(d *ChainhookDefinition) {
+				d.Options = &ChainhookOptions{IncludeContractSourceCode: BoolPtr(true), IncludeContractABI: BoolPtr(true)}
+			},
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var def *ChainhookDefinition
+			if tt.mutate != nil {
+				def = testDefinition()
+				tt.mutate(def)
+			}
+
+			err := def.Validate()
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var verrs ValidationErrors
+			if !errors.As(err, &verrs) {
+				t.Fatalf("expected ValidationErrors, got %v", err)
+			}
+			var got []string
+			for _, verr := range verrs {
+				got = append(got, verr.Field)
+				if verr.Reason == "" {
+					t.Errorf("%s: empty reason", verr.Field)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got errors on %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateRawEventFilter(t *testing.T) {
+	const fractional = `{"type":"stx_transfer","amount":"1.5"}`
+
+	// The fractional amount is rejected while decoding...
+	var filters ChainhookFilters
+	var decodeErr *EventFilterDecodeError
+	if err := json.Unmarshal([]byte(`{"events":[`+fractional+`]}`), &filters); !errors.As(err, &decodeErr) {
+		t.Fatalf("expected EventFilterDecodeError, got %v", err)
+	}
+
+	// ...and a raw filter of a registered type built by hand is not valid either
+	tests := []struct {
+		name   string
+		filter *RawEventFilter
+		field  string
+	}{
+		{"registered type", &RawEventFilter{Type: EventTypeSTXTransfer, Raw: json.RawMessage(fractional)}, "filters.events[0]"},
+		{"unknown type", &RawEventFilter{Type: "future_event", Raw: json.RawMessage(`{"type":"future_event"}`)}, "filters.events[0].type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := testDefinition()
+			def.Filters = NewChainhookFilters(tt.filter)
+			var verrs ValidationErrors
+			if err := def.Validate(); !errors.As(err, &verrs) || len(verrs) != 1 || verrs[0].Field != tt.field {
+				t.Fatalf("expected a single error on %s, got %v", tt.field, err)
+			}
+		})
+	}
+}